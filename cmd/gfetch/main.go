@@ -1,12 +1,18 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
 	"runtime/debug"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
+	"github.com/charmbracelet/x/term"
 	"github.com/fayssal-elmofatiche/gfetch/internal/git"
+	"github.com/fayssal-elmofatiche/gfetch/internal/i18n"
 	"github.com/fayssal-elmofatiche/gfetch/internal/ui"
 )
 
@@ -22,165 +28,676 @@ func getVersion() string {
 	return version
 }
 
+// parseSize parses a byte count with an optional K, M or G suffix.
+func parseSize(s string) (int64, error) {
+	s = strings.ToUpper(strings.TrimSuffix(strings.TrimSpace(s), "B"))
+	mult := int64(1)
+	switch {
+	case strings.HasSuffix(s, "K"):
+		mult = 1 << 10
+	case strings.HasSuffix(s, "M"):
+		mult = 1 << 20
+	case strings.HasSuffix(s, "G"):
+		mult = 1 << 30
+	}
+	n, err := strconv.ParseInt(strings.TrimRight(s, "KMG"), 10, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+	return n * mult, nil
+}
+
+// options holds the command-line flags that shape what is collected and
+// how it is rendered.
+type options struct {
+	showLines          bool
+	showStorage        bool
+	interactive        bool
+	watch              bool
+	compact            bool
+	oneline            bool
+	recurseSubmodules  bool
+	source             string
+	maxFileSize        int64
+	couplingSupport    int
+	couplingConfidence float64
+	limit              int // entries in top-N lists
+	width              int // output columns; 0 detects the terminal width
+	logo               *ui.CustomLogo
+	image              string // graphics protocol for image logos
+	ascii              bool
+	color              string
+	accessible         bool
+	heatmap            ui.HeatmapOptions // range, week start, metric and scale
+}
+
+// report is everything gfetch collects about the repository.
+type report struct {
+	gitInfo          git.Info
+	codeStats        git.CodeStats
+	contribStats     git.ContributorStats
+	lastActivity     string
+	velocity         git.Velocity
+	depManager       string
+	depCount         int
+	health           git.BranchHealth
+	hotFiles         []git.HotFile
+	hotspots         []git.Hotspot
+	coupled          []git.CoupledFiles
+	churn            git.Churn
+	activity         map[string]int // heatmap value per day
+	license          string
+	latestTag        string
+	cicd             []string
+	releases         []git.Release
+	stashCount       int
+	commitConvention string
+	storage          git.Storage
+	submodules       []git.Submodule
+}
+
+// change is a set of repository areas that changed, deciding which
+// collectors need to run again.
+type change uint8
+
+const (
+	changeWorktree change = 1 << iota // files on disk
+	changeIndex                       // the staging area
+	changeHistory                     // HEAD, branches, tags or the stash
+	changeAll      = changeWorktree | changeIndex | changeHistory
+)
+
 func main() {
-	if len(os.Args) > 1 && (os.Args[1] == "--version" || os.Args[1] == "-v") {
+	if len(os.Args) > 1 && os.Args[1] == "prompt" {
+		runPrompt(os.Args[2:])
+		return
+	}
+
+	var (
+		opts         options
+		showVersion  bool
+		maxFileSize  string
+		logoPath     string
+		logoColors   string
+		lang         string
+		heatmapRange string
+		weekStart    string
+		heatmapScale string
+	)
+	flag.BoolVar(&showVersion, "version", false, "print version")
+	flag.BoolVar(&showVersion, "v", false, "print version (shorthand)")
+	flag.BoolVar(&opts.showLines, "lines", false, "show a per-language code/comment/blank line table")
+	flag.BoolVar(&opts.showStorage, "storage", false, "show object database statistics and the largest blobs in history")
+	flag.BoolVar(&opts.interactive, "tui", false, "browse the sections interactively")
+	flag.BoolVar(&opts.compact, "compact", false, "show only the info panel with a small logo")
+	flag.BoolVar(&opts.oneline, "oneline", false, "print a one-line summary, e.g. for a MOTD or tmux status bar")
+	flag.BoolVar(&opts.watch, "watch", false, "keep running and refresh when the repository changes")
+	flag.IntVar(&opts.width, "width", 0, "lay out for this many columns instead of the terminal width")
+	flag.StringVar(&opts.source, "source", git.SourceWorktree, "read file stats from the worktree, index or head")
+	flag.BoolVar(&opts.recurseSubmodules, "recurse-submodules", false, "include files of initialized submodules in code stats (worktree source only)")
+	flag.StringVar(&maxFileSize, "max-file-size", "10M", "skip line counting for files larger than this (e.g. 512K, 10M, 1G)")
+	flag.IntVar(&opts.couplingSupport, "coupling-support", 3, "minimum shared commits for coupled files")
+	flag.Float64Var(&opts.couplingConfidence, "coupling-confidence", 0.5, "minimum confidence (0-1) for coupled files")
+	flag.StringVar(&logoPath, "logo", "", "show this PNG, JPEG or ASCII art file instead of the language logo")
+	flag.StringVar(&opts.image, "image", ui.ImageAuto, "draw image logos with kitty, iterm or sixel graphics, or none for half blocks")
+	flag.BoolVar(&opts.ascii, "ascii", false, "draw bars, charts and arrows with plain ASCII characters")
+	flag.BoolVar(&opts.accessible, "accessible", false, "screen reader friendly text instead of the logo, bars, sparklines and heatmap")
+	flag.StringVar(&opts.color, "color", ui.ColorAuto, "color output: auto (terminals, unless NO_COLOR is set), always or never")
+	flag.StringVar(&lang, "lang", "", "language for labels and dates, e.g. fr or de_DE (default from LANG)")
+	flag.StringVar(&heatmapRange, "heatmap-range", "", "heatmap range: last N months (e.g. 6m), a calendar year (e.g. 2024) or all years stacked (all)")
+	flag.StringVar(&weekStart, "week-start", "sunday", "first day of heatmap weeks: sunday or monday")
+	flag.StringVar(&opts.heatmap.Metric, "heatmap-metric", git.MetricCommits, "what the heatmap shows per day: commits, lines (changed) or authors")
+	flag.StringVar(&heatmapScale, "heatmap-scale", ui.ScaleLinear, "heatmap levels: linear, log, fixed, or four comma-separated thresholds (e.g. 1,5,10,20)")
+	flag.StringVar(&logoColors, "logo-colors", "", "comma-separated hex colors for the {N} tokens of an ASCII art logo")
+	flag.Parse()
+
+	if showVersion {
 		fmt.Println("gfetch", getVersion())
 		return
 	}
 
-	gitInfo, err := git.GetInfo()
+	switch opts.source {
+	case git.SourceWorktree, git.SourceIndex, git.SourceHead:
+	default:
+		fmt.Fprintf(os.Stderr, "gfetch: invalid --source %q (want worktree, index or head)\n", opts.source)
+		os.Exit(2)
+	}
+
+	if err := parseHeatmapRange(heatmapRange, &opts.heatmap); err != nil {
+		fmt.Fprintln(os.Stderr, "gfetch:", err)
+		os.Exit(2)
+	}
+	if err := parseHeatmapScale(heatmapScale, &opts.heatmap); err != nil {
+		fmt.Fprintln(os.Stderr, "gfetch:", err)
+		os.Exit(2)
+	}
+	switch weekStart {
+	case "sunday":
+	case "monday":
+		opts.heatmap.MondayFirst = true
+	default:
+		fmt.Fprintf(os.Stderr, "gfetch: invalid --week-start %q (want sunday or monday)\n", weekStart)
+		os.Exit(2)
+	}
+	switch opts.heatmap.Metric {
+	case git.MetricCommits, git.MetricLines, git.MetricAuthors:
+	default:
+		fmt.Fprintf(os.Stderr, "gfetch: invalid --heatmap-metric %q (want commits, lines or authors)\n", opts.heatmap.Metric)
+		os.Exit(2)
+	}
+
+	switch opts.color {
+	case ui.ColorAuto, ui.ColorAlways, ui.ColorNever:
+	default:
+		fmt.Fprintf(os.Stderr, "gfetch: invalid --color %q (want auto, always or never)\n", opts.color)
+		os.Exit(2)
+	}
+	setLocale(lang)
+
+	ui.SetColorMode(opts.color)
+	ui.SetASCII(opts.ascii)
+	ui.SetAccessible(opts.accessible)
+
+	switch opts.image {
+	case ui.ImageAuto:
+		opts.image = ui.ImageNone
+		if term.IsTerminal(os.Stdout.Fd()) && !opts.interactive {
+			opts.image = ui.DetectImageProtocol()
+		}
+	case ui.ImageNone, ui.ImageKitty, ui.ImageITerm, ui.ImageSixel:
+		if opts.interactive {
+			opts.image = ui.ImageNone
+		}
+	default:
+		fmt.Fprintf(os.Stderr, "gfetch: invalid --image %q (want auto, kitty, iterm, sixel or none)\n", opts.image)
+		os.Exit(2)
+	}
+	// Graphics protocols bypass both, so images are drawn with glyphs.
+	// Accessible output has no logo at all.
+	if opts.ascii || !ui.ColorEnabled() || opts.accessible {
+		opts.image = ui.ImageNone
+	}
+	// Sixel images are sized in pixels; without the cell size they can't
+	// be lined up with the info panel
+	cellWidth, cellHeight := ui.CellSize(os.Stdout.Fd())
+	ui.SetCellSize(cellWidth, cellHeight)
+	if opts.image == ui.ImageSixel && (cellWidth == 0 || cellHeight == 0) {
+		opts.image = ui.ImageNone
+	}
+
+	var err error
+	opts.maxFileSize, err = parseSize(maxFileSize)
 	if err != nil {
-		fmt.Println("Not a git repository")
+		fmt.Fprintf(os.Stderr, "gfetch: invalid --max-file-size %q\n", maxFileSize)
+		os.Exit(2)
+	}
+
+	if opts.interactive && (opts.watch || opts.compact || opts.oneline) {
+		fmt.Fprintln(os.Stderr, "gfetch: --tui can't be combined with --watch, --compact or --oneline")
+		os.Exit(2)
+	}
+	if opts.interactive {
+		if err := handOffTUI(); err != nil {
+			fmt.Fprintln(os.Stderr, "gfetch:", err)
+			os.Exit(2)
+		}
+	}
+
+	// Brief info is enough to set up; collect fills in the rest
+	var r report
+	r.gitInfo, err = git.GetBriefInfo()
+	if err != nil {
+		fmt.Println(i18n.T("Not a git repository"))
 		os.Exit(1)
 	}
 
-	var (
-		codeStats        git.CodeStats
-		contribStats     git.ContributorStats
-		lastActivity     string
-		velocity         git.Velocity
-		depManager       string
-		depCount         int
-		health           git.BranchHealth
-		hotFiles         []git.HotFile
-		dates            []string
-		license          string
-		latestTag        string
-		cicd             []string
-		releases         []git.Release
-		stashCount       int
-		commitConvention string
-	)
+	// Bare repositories have no worktree or index; read the HEAD tree
+	if r.gitInfo.Bare {
+		opts.source = git.SourceHead
+	}
+
+	// Partial clones fetch missing objects on demand. Read files from disk
+	// rather than blobs, and ask git not to fetch anything behind our back.
+	if r.gitInfo.PartialFilter != "" {
+		os.Setenv("GIT_NO_LAZY_FETCH", "1")
+		if !r.gitInfo.Bare {
+			opts.source = git.SourceWorktree
+		}
+	}
+
+	// A custom logo from the flags, or else from git config
+	if logoPath == "" {
+		logoPath, logoColors = git.GetLogoConfig()
+	}
+	if logoPath != "" && !opts.oneline {
+		var colors []string
+		if logoColors != "" {
+			colors = strings.Split(logoColors, ",")
+			for i := range colors {
+				colors[i] = strings.TrimSpace(colors[i])
+			}
+		}
+		opts.logo, err = ui.LoadLogo(logoPath, colors)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "gfetch: invalid logo:", err)
+			os.Exit(2)
+		}
+	}
+
+	// The interactive mode has room for longer lists
+	opts.limit = 5
+	if opts.interactive {
+		opts.limit = 20
+	}
+
+	collect(&r, opts, changeAll)
+	saveCache(&r)
+
+	if opts.interactive {
+		if err := runTUI(&r, opts); err != nil {
+			fmt.Fprintln(os.Stderr, "gfetch:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if opts.watch {
+		if err := watch(&r, opts); err != nil {
+			fmt.Fprintln(os.Stderr, "gfetch:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	fmt.Print(render(&r, opts))
+}
+
+// setLocale selects the language from --lang, or from the environment when
+// it's empty, and exits on a language gfetch doesn't speak.
+func setLocale(lang string) {
+	if lang == "" {
+		i18n.SetLocale(i18n.Detect())
+	} else if !i18n.SetLocale(lang) {
+		fmt.Fprintf(os.Stderr, "gfetch: unsupported --lang %q (want %s)\n", lang, strings.Join(i18n.Languages(), ", "))
+		os.Exit(2)
+	}
+}
+
+// collect runs the collectors affected by changed concurrently and stores
+// their results in r.
+func collect(r *report, opts options, changed change) {
+	// codeStats depends on where files are read from
+	codeChange := changeWorktree
+	switch opts.source {
+	case git.SourceIndex:
+		codeChange = changeIndex
+	case git.SourceHead:
+		codeChange = changeHistory
+	}
+
+	partial := r.gitInfo.PartialFilter != ""
+	// Resolved before the collectors start, since one of them replaces
+	// r.gitInfo
+	heatmap := heatmapOptions(r, opts)
 
 	var wg sync.WaitGroup
+	run := func(on change, f func()) {
+		if changed&on == 0 {
+			return
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			f()
+		}()
+	}
+	// The one-line summary only needs info, code stats and velocity; the
+	// compact layout only the info panel
+	runPanel := func(on change, f func()) {
+		if !opts.oneline {
+			run(on, f)
+		}
+	}
+	runSection := func(on change, f func()) {
+		if !opts.oneline && !opts.compact {
+			run(on, f)
+		}
+	}
+
+	// Branch, head commit and status are cheap and affected by everything
+	getInfo := git.GetInfo
+	if opts.oneline {
+		getInfo = git.GetBriefInfo
+	}
+	run(changeAll, func() {
+		if info, err := getInfo(); err == nil {
+			r.gitInfo = info
+		}
+	})
+
+	run(codeChange, func() {
+		// A one-off summary can use the cache; watch mode has to notice
+		// edits since the last commit
+		if opts.oneline && !opts.watch {
+			if stats, ok := cachedCodeStats(); ok {
+				r.codeStats = stats
+				return
+			}
+		}
+		r.codeStats = git.GetCodeStats(git.CodeStatsOptions{
+			Source:            opts.source,
+			MaxFileSize:       opts.maxFileSize,
+			RecurseSubmodules: opts.recurseSubmodules,
+		})
+	})
+
+	runPanel(changeHistory, func() {
+		r.contribStats = git.GetContributors(opts.limit)
+	})
+
+	runPanel(changeHistory, func() {
+		r.lastActivity = git.GetLastActivity()
+	})
+
+	run(changeHistory, func() {
+		r.velocity = git.GetVelocity()
+	})
+
+	runPanel(changeWorktree, func() {
+		r.depManager, r.depCount = git.GetDependencyCount()
+	})
 
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		codeStats = git.GetCodeStats()
-	}()
-
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		contribStats = git.GetContributors(5)
-	}()
-
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		lastActivity = git.GetLastActivity()
-	}()
-
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		velocity = git.GetVelocity()
-	}()
-
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		depManager, depCount = git.GetDependencyCount()
-	}()
-
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		health = git.GetBranchHealth()
-	}()
-
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		hotFiles = git.GetHotFiles(5)
-	}()
-
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		dates, _ = git.GetCommitDates()
-	}()
-
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		license = git.GetLicense()
-	}()
-
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		latestTag = git.GetLatestTag()
-	}()
-
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		cicd = git.GetCICD()
-	}()
-
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		releases = git.GetRecentReleases(5)
-	}()
-
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		stashCount = git.GetStashCount()
-	}()
-
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		commitConvention = git.GetCommitConvention()
-	}()
+	runPanel(changeHistory, func() {
+		r.health = git.GetBranchHealth()
+	})
+
+	runSection(changeHistory, func() {
+		r.hotFiles = git.GetHotFiles(opts.limit)
+	})
+
+	runSection(changeHistory, func() {
+		r.coupled = git.GetCoupledFiles(git.CouplingOptions{
+			MinSupport:    opts.couplingSupport,
+			MinConfidence: opts.couplingConfidence,
+		}, 5)
+	})
+
+	// Line counts per commit need every blob in the window
+	if !partial {
+		runSection(changeHistory, func() {
+			r.churn = git.GetChurn(5)
+		})
+	}
+
+	runSection(changeHistory, func() {
+		r.activity, _ = git.GetDailyActivity(heatmap.Metric, "", heatmap.Since())
+	})
+
+	runPanel(changeWorktree, func() {
+		r.license = git.GetLicense()
+	})
+
+	runPanel(changeHistory, func() {
+		r.latestTag = git.GetLatestTag()
+	})
+
+	runPanel(changeWorktree, func() {
+		r.cicd = git.GetCICD()
+	})
+
+	runSection(changeHistory, func() {
+		r.releases = git.GetRecentReleases(opts.limit)
+	})
+
+	runPanel(changeHistory, func() {
+		r.stashCount = git.GetStashCount()
+	})
+
+	runPanel(changeHistory, func() {
+		r.commitConvention = git.GetCommitConvention()
+	})
+
+	runSection(changeWorktree|changeIndex, func() {
+		r.submodules = git.GetSubmodules()
+	})
+
+	if opts.showStorage {
+		runSection(changeHistory, func() {
+			r.storage = git.GetStorage(5)
+		})
+	}
 
 	wg.Wait()
 
+	if !opts.oneline && !opts.compact && changed&(codeChange|changeHistory) != 0 {
+		r.hotspots = git.GetHotspots(r.codeStats, 5)
+	}
+}
+
+// renderParams gathers what the info panel and one-line summary show.
+func renderParams(r *report) ui.RenderParams {
+	return ui.RenderParams{
+		Info:             r.gitInfo,
+		Size:             r.codeStats.Size,
+		FileCount:        r.codeStats.FileCount,
+		SkippedFiles:     r.codeStats.Skipped,
+		LFS:              r.codeStats.LFS,
+		Languages:        r.codeStats.Languages,
+		LOC:              r.codeStats.LOC,
+		Lines:            r.codeStats.Lines,
+		LastActivity:     r.lastActivity,
+		Velocity:         r.velocity,
+		DepManager:       r.depManager,
+		DepCount:         r.depCount,
+		Health:           r.health,
+		License:          r.license,
+		LatestTag:        r.latestTag,
+		CICD:             r.cicd,
+		StashCount:       r.stashCount,
+		Contributors:     r.contribStats.Total,
+		TestRatio:        r.codeStats.TestRatio,
+		CommitConvention: r.commitConvention,
+	}
+}
+
+// renderOverview renders the logo next to the info panel, laid out for
+// width columns. The compact variant uses a small logo and shows the
+// language bar inside the panel.
+func renderOverview(r *report, opts options, width int) string {
 	primaryLang := ""
-	if len(codeStats.Languages) > 0 {
-		primaryLang = codeStats.Languages[0].Name
+	if len(r.codeStats.Languages) > 0 {
+		primaryLang = r.codeStats.Languages[0].Name
 	}
-	logo := ui.RenderLogo(primaryLang)
-	info := ui.RenderInfo(ui.RenderParams{
-		Info:             gitInfo,
-		Size:             codeStats.Size,
-		FileCount:        codeStats.FileCount,
-		Languages:        codeStats.Languages,
-		LOC:              codeStats.LOC,
-		LastActivity:     lastActivity,
-		Velocity:         velocity,
-		DepManager:       depManager,
-		DepCount:         depCount,
-		Health:           health,
-		License:          license,
-		LatestTag:        latestTag,
-		CICD:             cicd,
-		StashCount:       stashCount,
-		Contributors:     contribStats.Total,
-		TestRatio:        codeStats.TestRatio,
-		CommitConvention: commitConvention,
-	})
-	fmt.Println(ui.RenderLayout(logo, info))
+	params := renderParams(r)
+	params.LanguageBar = opts.compact
+	if opts.accessible {
+		return ui.RenderInfo(params)
+	}
+
+	var logo string
+	switch {
+	case inlineLogo(opts, width):
+		logo = opts.logo.Placeholder(opts.compact)
+	case opts.logo != nil:
+		logo = opts.logo.Render(primaryLang, opts.compact)
+	case opts.compact:
+		logo = ui.RenderSmallLogo(primaryLang)
+	default:
+		logo = ui.RenderLogo(primaryLang)
+	}
+	return ui.RenderLayout(logo, ui.RenderInfo(params), width)
+}
+
+// inlineLogo reports whether the logo is an image drawn with a terminal
+// graphics protocol, over a placeholder in the layout. Layouts too narrow
+// for the logo leave it out.
+func inlineLogo(opts options, width int) bool {
+	if opts.image == ui.ImageNone || opts.logo == nil || !opts.logo.IsImage() {
+		return false
+	}
+	cols, _ := opts.logo.Size(opts.compact)
+	return width <= 0 || cols <= width
+}
 
-	fmt.Println(ui.RenderLanguageBar(codeStats.Languages, 50))
+// heatmapOptions completes the heatmap flags with what was collected. Line
+// counts need every blob in history, so partial clones show commits.
+func heatmapOptions(r *report, opts options) ui.HeatmapOptions {
+	heatmap := opts.heatmap
+	heatmap.HistoryStart = r.gitInfo.HistoryStart
+	if heatmap.Metric == git.MetricLines && r.gitInfo.PartialFilter != "" {
+		heatmap.Metric = git.MetricCommits
+	}
+	return heatmap
+}
+
+// parseHeatmapRange sets the heatmap range from --heatmap-range: "" for the
+// past year, "6m" for the last 6 months, "2024" for a calendar year or
+// "all" for every year stacked.
+func parseHeatmapRange(s string, heatmap *ui.HeatmapOptions) error {
+	switch {
+	case s == "":
+		return nil
+	case s == "all":
+		heatmap.AllYears = true
+		return nil
+	case strings.HasSuffix(s, "m"):
+		n, err := strconv.Atoi(strings.TrimSuffix(s, "m"))
+		if err == nil && n > 0 {
+			heatmap.Months = n
+			return nil
+		}
+	default:
+		n, err := strconv.Atoi(s)
+		if err == nil && n >= 1970 && n <= time.Now().Year() {
+			heatmap.Year = n
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid --heatmap-range %q (want e.g. 6m, 2024 or all)", s)
+}
+
+// parseHeatmapScale sets the heatmap scale from --heatmap-scale: linear,
+// log, fixed (default thresholds per metric) or four ascending thresholds.
+func parseHeatmapScale(s string, heatmap *ui.HeatmapOptions) error {
+	switch s {
+	case ui.ScaleLinear, ui.ScaleLog, ui.ScaleFixed:
+		heatmap.Scale = s
+		return nil
+	}
+	parts := strings.Split(s, ",")
+	if len(parts) == len(heatmap.Thresholds) {
+		prev := 0
+		for i, p := range parts {
+			n, err := strconv.Atoi(strings.TrimSpace(p))
+			if err != nil || n <= prev {
+				return fmt.Errorf("invalid --heatmap-scale %q (thresholds must be ascending positive numbers)", s)
+			}
+			heatmap.Thresholds[i], prev = n, n
+		}
+		heatmap.Scale = ui.ScaleFixed
+		return nil
+	}
+	return fmt.Errorf("invalid --heatmap-scale %q (want linear, log, fixed or four thresholds like 1,5,10,20)", s)
+}
+
+// halfHeightRows is the terminal height below which the heatmap is drawn
+// at half height.
+const halfHeightRows = 30
 
-	if len(contribStats.Top) > 0 {
-		fmt.Println(ui.RenderContributors(contribStats))
+// outputSize returns the columns and rows to lay out for: the --width
+// override, the terminal size, or 0 (unlimited) when output isn't a
+// terminal.
+func outputSize(opts options) (width, height int) {
+	if term.IsTerminal(os.Stdout.Fd()) {
+		width, height, _ = term.GetSize(os.Stdout.Fd())
+	}
+	if opts.width > 0 {
+		width = opts.width
+	}
+	return width, height
+}
+
+// render lays out every section of the one-shot display.
+func render(r *report, opts options) string {
+	width, height := outputSize(opts)
+
+	if opts.oneline {
+		return ui.Fit(ui.RenderOneline(renderParams(r)), width) + "\n"
 	}
 
-	if len(hotFiles) > 0 {
-		fmt.Println(ui.RenderHotFiles(hotFiles))
+	var b strings.Builder
+	section := func(s string) {
+		b.WriteString(ui.Fit(s, width))
+		b.WriteString("\n")
 	}
 
-	if len(releases) > 0 {
-		fmt.Println(ui.RenderReleases(releases))
+	section(renderOverview(r, opts, width))
+	if opts.compact {
+		return withInlineLogo(r, opts, b.String(), width, height)
 	}
 
-	if len(dates) > 0 {
-		fmt.Println(ui.RenderHeatmap(dates))
+	barWidth := 50
+	if width > 0 && width < barWidth {
+		barWidth = width
+	}
+	section(ui.RenderLanguageBar(r.codeStats.Languages, barWidth))
+
+	if opts.showLines && len(r.codeStats.Lines) > 0 {
+		section(ui.RenderLineCounts(r.codeStats.Lines))
+	}
+
+	if len(r.contribStats.Top) > 0 {
+		section(ui.RenderContributors(r.contribStats))
+	}
+
+	if len(r.hotFiles) > 0 {
+		section(ui.RenderHotFiles(r.hotFiles))
+	}
+
+	if len(r.hotspots) > 0 {
+		section(ui.RenderHotspots(r.hotspots))
+	}
+
+	if len(r.coupled) > 0 {
+		section(ui.RenderCoupledFiles(r.coupled))
+	}
+
+	if len(r.churn.Files) > 0 {
+		section(ui.RenderChurn(r.churn))
+	}
+
+	if len(r.releases) > 0 {
+		section(ui.RenderReleases(r.releases))
+	}
+
+	if len(r.submodules) > 0 {
+		section(ui.RenderSubmodules(r.submodules))
+	}
+
+	if opts.showStorage {
+		section(ui.RenderStorage(r.storage))
+	}
+
+	if len(r.activity) > 0 {
+		heatmap := heatmapOptions(r, opts)
+		heatmap.Width = width
+		heatmap.HalfHeight = height > 0 && height < halfHeightRows
+		section(ui.RenderHeatmap(r.activity, heatmap))
+	}
+	return withInlineLogo(r, opts, b.String(), width, height)
+}
+
+// withInlineLogo draws an image logo over its placeholder at the top of out.
+// Output taller than the terminal has scrolled the placeholder away, so it
+// is rendered again with a half-block logo instead.
+func withInlineLogo(r *report, opts options, out string, width, height int) string {
+	if !inlineLogo(opts, width) {
+		return out
+	}
+	lines := strings.Count(out, "\n")
+	if height > 0 && lines >= height {
+		opts.image = ui.ImageNone
+		return render(r, opts)
 	}
+	return out + ui.OverlayImage(opts.logo.InlineImage(opts.image, opts.compact), lines)
 }
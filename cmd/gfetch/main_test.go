@@ -0,0 +1,66 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/fayssal-elmofatiche/gfetch/internal/ui"
+)
+
+func TestParseHeatmapRange(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    ui.HeatmapOptions
+		wantErr bool
+	}{
+		{in: "", want: ui.HeatmapOptions{}},
+		{in: "all", want: ui.HeatmapOptions{AllYears: true}},
+		{in: "6m", want: ui.HeatmapOptions{Months: 6}},
+		{in: "2024", want: ui.HeatmapOptions{Year: 2024}},
+		{in: "0m", wantErr: true},
+		{in: "m", wantErr: true},
+		{in: "1969", wantErr: true},
+		{in: "9999", wantErr: true},
+		{in: "last year", wantErr: true},
+	}
+	for _, tt := range tests {
+		var got ui.HeatmapOptions
+		err := parseHeatmapRange(tt.in, &got)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseHeatmapRange(%q) error = %v, want error %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got != tt.want {
+			t.Errorf("parseHeatmapRange(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseHeatmapScale(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    ui.HeatmapOptions
+		wantErr bool
+	}{
+		{in: "linear", want: ui.HeatmapOptions{Scale: ui.ScaleLinear}},
+		{in: "log", want: ui.HeatmapOptions{Scale: ui.ScaleLog}},
+		{in: "fixed", want: ui.HeatmapOptions{Scale: ui.ScaleFixed}},
+		{in: "1,5,10,20", want: ui.HeatmapOptions{Scale: ui.ScaleFixed, Thresholds: [4]int{1, 5, 10, 20}}},
+		{in: "1, 5, 10, 20", want: ui.HeatmapOptions{Scale: ui.ScaleFixed, Thresholds: [4]int{1, 5, 10, 20}}},
+		{in: "5,1,10,20", wantErr: true},
+		{in: "1,1,10,20", wantErr: true},
+		{in: "0,5,10,20", wantErr: true},
+		{in: "1,5,10", wantErr: true},
+		{in: "quadratic", wantErr: true},
+	}
+	for _, tt := range tests {
+		var got ui.HeatmapOptions
+		err := parseHeatmapScale(tt.in, &got)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseHeatmapScale(%q) error = %v, want error %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got != tt.want {
+			t.Errorf("parseHeatmapScale(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
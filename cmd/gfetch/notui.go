@@ -0,0 +1,56 @@
+//go:build !tui
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// tuiBinary is gfetch built with the tui tag. Bubble Tea queries the
+// terminal's background color as soon as it is linked in, which stalls
+// every run on terminals that don't answer, so plain gfetch leaves the
+// interactive mode to this binary. The query is in Bubble Tea's package
+// init, which runs before any gfetch code, so it can't be deferred.
+const tuiBinary = "gfetch-tui"
+
+// handOffTUI runs gfetch-tui with the same arguments, looking next to this
+// executable first and then in PATH, and exits with its status.
+func handOffTUI() error {
+	path, err := exec.LookPath(tuiBinary)
+	dir := "this executable"
+	if self, selfErr := os.Executable(); selfErr == nil {
+		dir = filepath.Dir(self)
+		sibling := filepath.Join(dir, tuiBinary)
+		if _, statErr := os.Stat(sibling); statErr == nil {
+			path, err = sibling, nil
+		} else if _, statErr := os.Stat(sibling + ".exe"); statErr == nil {
+			path, err = sibling+".exe", nil
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("--tui needs %s, which is neither next to %s nor in PATH.\n"+
+			"Install it from a release archive, or reinstall gfetch with the interactive mode built in:\n"+
+			"  go install -tags tui github.com/fayssal-elmofatiche/gfetch/cmd/gfetch@latest", tuiBinary, dir)
+	}
+
+	cmd := exec.Command(path, os.Args[1:]...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := cmd.Run(); err != nil {
+		var exit *exec.ExitError
+		if errors.As(err, &exit) {
+			os.Exit(exit.ExitCode())
+		}
+		return err
+	}
+	os.Exit(0)
+	return nil
+}
+
+// runTUI is unreachable in this build: handOffTUI never returns nil.
+func runTUI(r *report, opts options) error {
+	return errors.New("built without the tui tag")
+}
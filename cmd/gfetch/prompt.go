@@ -0,0 +1,88 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fayssal-elmofatiche/gfetch/internal/cache"
+	"github.com/fayssal-elmofatiche/gfetch/internal/git"
+	"github.com/fayssal-elmofatiche/gfetch/internal/ui"
+)
+
+// defaultPromptFields are shown by "gfetch prompt" without --fields.
+const defaultPromptFields = "branch,upstream,status,stash"
+
+// runPrompt implements "gfetch prompt": a shell prompt segment computed from
+// cheap git plumbing plus the cache of the last full run, within a latency
+// budget. Outside a repository it prints nothing and succeeds, so prompts
+// can call it unconditionally.
+func runPrompt(args []string) {
+	fs := flag.NewFlagSet("gfetch prompt", flag.ExitOnError)
+	fields := fs.String("fields", defaultPromptFields, "comma-separated fields: branch, upstream, status, stash, lang, loc")
+	timeout := fs.Duration("timeout", 50*time.Millisecond, "latency budget; fields not ready in time are left out")
+	ascii := fs.Bool("ascii", false, "use plain ASCII markers for ahead, behind and stash")
+	lang := fs.String("lang", "", "language for the status, e.g. fr or de_DE (default from LANG)")
+	fs.Parse(args)
+	setLocale(*lang)
+	ui.SetASCII(*ascii)
+
+	start := time.Now()
+	list := strings.Split(*fields, ",")
+
+	// Prompts run on every command line; don't take the index lock for the
+	// opportunistic refresh git status does
+	os.Setenv("GIT_OPTIONAL_LOCKS", "0")
+
+	var language string
+	var loc int
+	if slices.Contains(list, ui.PromptLanguage) || slices.Contains(list, ui.PromptLOC) {
+		if dirs, err := git.GetRepoDirs(); err == nil {
+			if e, ok := cache.Load(dirs.GitDir); ok {
+				language, loc = e.Language, e.LOC
+			}
+		}
+	}
+
+	p := git.GetPrompt(list, *timeout-time.Since(start))
+	if segment := ui.RenderPrompt(list, p, language, loc); segment != "" {
+		fmt.Println(segment)
+	}
+}
+
+// saveCache remembers the expensive results of a run for "gfetch prompt".
+// Failing to write the cache is not worth reporting.
+func saveCache(r *report) {
+	dirs, err := git.GetRepoDirs()
+	if err != nil || r.codeStats.FileCount == 0 {
+		return
+	}
+	e := cache.Entry{Head: r.gitInfo.CommitHash, LOC: r.codeStats.LOC, UpdatedAt: time.Now()}
+	if len(r.codeStats.Languages) > 0 {
+		e.Language = r.codeStats.Languages[0].Name
+		e.Share = r.codeStats.Languages[0].Percentage
+	}
+	cache.Save(dirs.GitDir, e)
+}
+
+// cachedCodeStats returns the language and line count the last full run
+// saved, if it ran at the current HEAD. They're all the one-line summary
+// shows, and counting lines is by far its most expensive step.
+func cachedCodeStats() (git.CodeStats, bool) {
+	dirs, err := git.GetRepoDirs()
+	if err != nil {
+		return git.CodeStats{}, false
+	}
+	e, ok := cache.Load(dirs.GitDir)
+	// Entries from before the share was saved can't fill in the summary
+	if !ok || e.Share == 0 || e.Head != git.GetHeadHash() {
+		return git.CodeStats{}, false
+	}
+	return git.CodeStats{
+		Languages: []git.LanguageStat{{Name: e.Language, Percentage: e.Share}},
+		LOC:       e.LOC,
+	}, true
+}
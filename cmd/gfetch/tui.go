@@ -0,0 +1,31 @@
+//go:build tui
+
+package main
+
+import "github.com/fayssal-elmofatiche/gfetch/internal/tui"
+
+// handOffTUI is a no-op: this build has the interactive mode built in.
+func handOffTUI() error {
+	return nil
+}
+
+// runTUI opens the collected report in the interactive mode.
+func runTUI(r *report, opts options) error {
+	data := tui.Data{
+		Overview:     renderOverview(r, opts, 0),
+		CodeStats:    r.codeStats,
+		Contributors: r.contribStats,
+		HotFiles:     r.hotFiles,
+		Hotspots:     r.hotspots,
+		Coupled:      r.coupled,
+		Churn:        r.churn,
+		Releases:     r.releases,
+		Submodules:   r.submodules,
+		Activity:     r.activity,
+		Heatmap:      heatmapOptions(r, opts),
+	}
+	if opts.showStorage {
+		data.Storage = &r.storage
+	}
+	return tui.Run(data)
+}
@@ -0,0 +1,241 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fayssal-elmofatiche/gfetch/internal/git"
+	"github.com/fayssal-elmofatiche/gfetch/internal/ui"
+	"github.com/fsnotify/fsnotify"
+)
+
+// watchDebounce merges bursts of events, such as a checkout rewriting many
+// files, into a single refresh.
+const watchDebounce = 300 * time.Millisecond
+
+// pollInterval is how often the repository is checked when file system
+// notifications aren't available.
+const pollInterval = 2 * time.Second
+
+// watch redraws the report whenever the repository changes, recomputing
+// only the collectors affected by the change. It runs until interrupted.
+func watch(r *report, opts options) error {
+	// The index refresh git status does would rewrite the index we watch
+	// and trigger another refresh, forever
+	os.Setenv("GIT_OPTIONAL_LOCKS", "0")
+
+	dirs, err := git.GetRepoDirs()
+	if err != nil {
+		return err
+	}
+
+	redraw := func() {
+		// Clear the screen and home the cursor before drawing
+		fmt.Print(ui.ClearImages(opts.image) + "\x1b[H\x1b[2J" + render(r, opts))
+	}
+	redraw()
+
+	changes := make(chan change)
+	if w, err := newFSWatcher(dirs); err == nil {
+		defer w.Close()
+		go w.run(changes)
+	} else {
+		go poll(dirs, changes)
+	}
+
+	timer := time.NewTimer(watchDebounce)
+	timer.Stop()
+	var pending change
+	for {
+		select {
+		case c := <-changes:
+			pending |= c
+			timer.Reset(watchDebounce)
+		case <-timer.C:
+			collect(r, opts, pending)
+			saveCache(r)
+			pending = 0
+			redraw()
+		}
+	}
+}
+
+// fsWatcher turns file system notifications for the git directories and
+// the tracked parts of the working tree into changes.
+type fsWatcher struct {
+	*fsnotify.Watcher
+	dirs git.RepoDirs
+}
+
+func newFSWatcher(dirs git.RepoDirs) (*fsWatcher, error) {
+	nw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	w := &fsWatcher{Watcher: nw, dirs: dirs}
+
+	// HEAD and the index live in the git directory, which for a linked
+	// worktree differs from the common directory holding the refs
+	paths := []string{dirs.GitDir}
+	if dirs.CommonDir != dirs.GitDir {
+		paths = append(paths, dirs.CommonDir)
+	}
+	if err := w.addTree(filepath.Join(dirs.CommonDir, "refs")); err != nil {
+		w.Close()
+		return nil, err
+	}
+
+	// Only directories with tracked files, so build output and
+	// dependency caches don't exhaust the watch limit
+	if dirs.Root != "" {
+		for _, dir := range git.GetTrackedDirs() {
+			paths = append(paths, filepath.Join(dirs.Root, dir))
+		}
+	}
+	for _, path := range paths {
+		if err := w.Add(path); err != nil {
+			w.Close()
+			return nil, err
+		}
+	}
+	return w, nil
+}
+
+// addTree watches dir and every directory below it.
+func (w *fsWatcher) addTree(dir string) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || !d.IsDir() {
+			return err
+		}
+		return w.Add(path)
+	})
+}
+
+func (w *fsWatcher) run(changes chan<- change) {
+	for {
+		select {
+		case ev, ok := <-w.Events:
+			if !ok {
+				return
+			}
+			c := w.classify(ev.Name)
+			if c == 0 {
+				continue
+			}
+			// Follow new directories: branches like feature/x and new
+			// source directories
+			if ev.Has(fsnotify.Create) {
+				if info, err := os.Stat(ev.Name); err == nil && info.IsDir() {
+					w.addTree(ev.Name)
+				}
+			}
+			changes <- c
+		case _, ok := <-w.Errors:
+			if !ok {
+				return
+			}
+		}
+	}
+}
+
+// classify maps a changed path to the part of the repository it belongs to.
+// Lock files and git internals that don't affect the report are ignored.
+func (w *fsWatcher) classify(path string) change {
+	if strings.HasSuffix(path, ".lock") {
+		return 0
+	}
+	if within(path, w.dirs.GitDir) || within(path, w.dirs.CommonDir) {
+		switch {
+		case path == filepath.Join(w.dirs.GitDir, "index"):
+			return changeIndex
+		case path == filepath.Join(w.dirs.GitDir, "HEAD"),
+			path == filepath.Join(w.dirs.CommonDir, "packed-refs"),
+			within(path, filepath.Join(w.dirs.CommonDir, "refs")):
+			return changeHistory
+		}
+		return 0
+	}
+	if within(path, filepath.Join(w.dirs.Root, ".git")) {
+		return 0
+	}
+	return changeWorktree
+}
+
+// within reports whether path is dir or inside it.
+func within(path, dir string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// poll checks the repository periodically, for file systems without
+// notifications (network mounts, some containers) or when the watch limit
+// is reached.
+func poll(dirs git.RepoDirs, changes chan<- change) {
+	prev := takeSnapshot(dirs)
+	for range time.Tick(pollInterval) {
+		cur := takeSnapshot(dirs)
+		var c change
+		if cur.worktree != prev.worktree {
+			c |= changeWorktree
+		}
+		if cur.index != prev.index {
+			c |= changeIndex
+		}
+		if cur.history != prev.history {
+			c |= changeHistory
+		}
+		if c != 0 {
+			changes <- c
+		}
+		prev = cur
+	}
+}
+
+// snapshot fingerprints the parts of a repository with file modification
+// times and sizes.
+type snapshot struct {
+	worktree string
+	index    string
+	history  string
+}
+
+func takeSnapshot(dirs git.RepoDirs) snapshot {
+	var s snapshot
+	s.index = fingerprint(filepath.Join(dirs.GitDir, "index"))
+
+	var history strings.Builder
+	history.WriteString(fingerprint(filepath.Join(dirs.GitDir, "HEAD")))
+	history.WriteString(fingerprint(filepath.Join(dirs.CommonDir, "packed-refs")))
+	filepath.WalkDir(filepath.Join(dirs.CommonDir, "refs"), func(path string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			history.WriteString(path + fingerprint(path))
+		}
+		return nil
+	})
+	s.history = history.String()
+
+	// Modified and untracked files, so edits to an already modified file
+	// are noticed too
+	if dirs.Root != "" {
+		var worktree strings.Builder
+		for _, path := range git.GetChangedFiles() {
+			worktree.WriteString(path + fingerprint(filepath.Join(dirs.Root, path)))
+		}
+		s.worktree = worktree.String()
+	}
+	return s
+}
+
+// fingerprint describes a file by modification time and size, or is empty
+// if the file doesn't exist.
+func fingerprint(path string) string {
+	info, err := os.Stat(path)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("@%d:%d;", info.ModTime().UnixNano(), info.Size())
+}
@@ -0,0 +1,78 @@
+// Package cache keeps results of expensive collectors on disk, so fast
+// modes like "gfetch prompt" can show them without recomputing.
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Entry is what is remembered about one repository.
+type Entry struct {
+	Head      string    `json:"head"` // commit the entry was computed at
+	Language  string    `json:"language"`
+	Share     float64   `json:"share"` // percentage of the code in Language
+	LOC       int       `json:"loc"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// path returns the cache file for a repository, identified by its absolute
+// git directory so that linked worktrees get their own entries.
+func path(gitDir string) (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(gitDir))
+	return filepath.Join(dir, "gfetch", hex.EncodeToString(sum[:8])+".json"), nil
+}
+
+// Load reads the entry for a repository. ok is false if there is none.
+func Load(gitDir string) (e Entry, ok bool) {
+	p, err := path(gitDir)
+	if err != nil {
+		return e, false
+	}
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return e, false
+	}
+	if err := json.Unmarshal(data, &e); err != nil {
+		return e, false
+	}
+	return e, true
+}
+
+// Save stores the entry for a repository, replacing the file atomically so
+// a concurrent Load never sees a partial write.
+func Save(gitDir string, e Entry) error {
+	p, err := path(gitDir)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return err
+	}
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(p), ".gfetch-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), p)
+}
@@ -0,0 +1,330 @@
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// CodeStats holds all file-derived metrics computed in a single git ls-files pass.
+type CodeStats struct {
+	Languages []LanguageStat
+	Size      string
+	FileCount int
+	LOC       int             // code lines, excluding comments and blanks
+	Lines     []LanguageLines // per-language breakdown, sorted by code lines
+	TestRatio TestRatio
+	Files     map[string]FileMetrics // keyed by path as listed by git ls-files
+	Skipped   int                    // source files over MaxFileSize, binary or unreadable
+	LFS       LFSStats
+}
+
+// FileMetrics holds the language, size and complexity proxy of a single
+// source file.
+type FileMetrics struct {
+	Language   string
+	LOC        int // code lines
+	Complexity int // sum of indentation depth over code lines
+}
+
+// CodeStatsOptions configures how GetCodeStats reads tracked files.
+type CodeStatsOptions struct {
+	Source      string // SourceWorktree (default), SourceIndex or SourceHead
+	MaxFileSize int64  // files larger than this only count towards Size; 0 means DefaultMaxFileSize
+
+	// RecurseSubmodules includes files of initialized submodules. It only
+	// applies to SourceWorktree; otherwise submodules are left out.
+	RecurseSubmodules bool
+}
+
+// DefaultMaxFileSize keeps huge fixtures and data dumps from dominating
+// scan time. It is large enough for amalgamated sources like sqlite3.c.
+const DefaultMaxFileSize = 10 << 20
+
+// sniffLen is how much of each file is inspected for binary content and
+// language heuristics, matching git's own binary detection.
+const sniffLen = 8000
+
+// fileResult is what a worker learns about one tracked file.
+type fileResult struct {
+	lang     string
+	counts   sourceCounts
+	skipped  bool // binary or unreadable
+	lfs      bool // stored in Git LFS; size is the logical size
+	size     int64
+	lfsLocal bool
+}
+
+// GetCodeStats enumerates tracked files once and computes language stats,
+// repo size, lines of code, and test ratio in a single pass. Files are
+// scanned by a pool of GOMAXPROCS workers.
+func GetCodeStats(opts CodeStatsOptions) CodeStats {
+	maxSize := opts.MaxFileSize
+	if maxSize <= 0 {
+		maxSize = DefaultMaxFileSize
+	}
+
+	source, err := newFileSource(opts.Source, opts.RecurseSubmodules)
+	if err != nil {
+		return CodeStats{Size: "0 B"}
+	}
+	defer source.close()
+
+	tracked, err := source.list()
+	if err != nil || len(tracked) == 0 {
+		return CodeStats{Size: "0 B"}
+	}
+	files := make([]string, len(tracked))
+	for i, f := range tracked {
+		files[i] = f.path
+	}
+
+	var totalSize int64
+	detector := newLanguageDetector(files)
+	classifier := newPathClassifier(files, false)
+
+	// Pick the files worth opening; everything counts towards Size
+	var jobs []int
+	isLFS := make([]bool, len(tracked))
+	skipped := 0
+	for i, tf := range tracked {
+		if classifier.lfs(tf.path) {
+			// Measured by the workers, which resolve pointers to logical sizes
+			isLFS[i] = true
+			jobs = append(jobs, i)
+			continue
+		}
+		totalSize += tf.size
+		if classifier.excluded(tf.path) {
+			continue
+		}
+		if tf.size > maxSize {
+			if classifier.language(tf.path) != "" || detector.detect(tf.path, func() []byte { return nil }) != "" {
+				skipped++
+			}
+			continue
+		}
+		jobs = append(jobs, i)
+	}
+
+	results := make([]fileResult, len(tracked))
+	work := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < runtime.GOMAXPROCS(0); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range work {
+				if isLFS[i] {
+					size, local := lfsFile(source, tracked[i])
+					results[i] = fileResult{lfs: true, size: size, lfsLocal: local}
+					continue
+				}
+				results[i] = scanFile(source, tracked[i], detector, classifier.language(tracked[i].path))
+			}
+		}()
+	}
+	for _, i := range jobs {
+		work <- i
+	}
+	close(work)
+	wg.Wait()
+
+	langBytes := make(map[string]int64)
+	var totalCodeBytes int64
+	var totalLOC int
+	var codeLines, testLines int
+	fileMetrics := make(map[string]FileMetrics)
+	langLines := make(map[string]*LanguageLines)
+
+	var lfs LFSStats
+	var lfsSize int64
+	for _, i := range jobs {
+		r := results[i]
+		if r.lfs {
+			// LFS content is assets, not code: it only counts towards Size
+			if !isLFS[i] {
+				// A pointer without the attribute was counted at pointer size
+				totalSize -= tracked[i].size
+			}
+			lfs.Files++
+			lfsSize += r.size
+			totalSize += r.size
+			if r.lfsLocal {
+				lfs.Local++
+			}
+			continue
+		}
+		if r.skipped {
+			skipped++
+			continue
+		}
+		if r.lang == "" {
+			continue
+		}
+		file := tracked[i].path
+
+		langBytes[r.lang] += tracked[i].size
+		totalCodeBytes += tracked[i].size
+
+		lines := r.counts.code
+		totalLOC += lines
+		fileMetrics[file] = FileMetrics{Language: r.lang, LOC: lines, Complexity: r.counts.complexity}
+
+		ll, ok := langLines[r.lang]
+		if !ok {
+			ll = &LanguageLines{Name: r.lang}
+			langLines[r.lang] = ll
+		}
+		ll.Files++
+		ll.Code += r.counts.code
+		ll.Comment += r.counts.comment
+		ll.Blank += r.counts.blank
+
+		if isTestFile(file) {
+			testLines += lines
+		} else {
+			codeLines += lines
+		}
+	}
+
+	if lfs.Files > 0 {
+		lfs.Size = formatSize(lfsSize)
+	}
+
+	// Language stats
+	var stats []LanguageStat
+	if totalCodeBytes > 0 {
+		for lang, bytes := range langBytes {
+			pct := float64(bytes) / float64(totalCodeBytes) * 100
+			color := languageColors[lang]
+			if color == "" {
+				color = languageColors["Other"]
+			}
+			stats = append(stats, LanguageStat{Name: lang, Percentage: pct, Color: color})
+		}
+		sort.Slice(stats, func(i, j int) bool {
+			return stats[i].Percentage > stats[j].Percentage
+		})
+	}
+
+	var lines []LanguageLines
+	for _, ll := range langLines {
+		lines = append(lines, *ll)
+	}
+	sort.Slice(lines, func(i, j int) bool {
+		if lines[i].Code != lines[j].Code {
+			return lines[i].Code > lines[j].Code
+		}
+		return lines[i].Name < lines[j].Name
+	})
+
+	// Test ratio
+	ratio := 0.0
+	if codeLines > 0 {
+		ratio = float64(testLines) / float64(codeLines)
+	}
+
+	return CodeStats{
+		Languages: stats,
+		Size:      formatSize(totalSize),
+		FileCount: len(files),
+		LOC:       totalLOC,
+		Lines:     lines,
+		TestRatio: TestRatio{CodeLines: codeLines, TestLines: testLines, Ratio: ratio},
+		Files:     fileMetrics,
+		Skipped:   skipped,
+		LFS:       lfs,
+	}
+}
+
+func formatSize(size int64) string {
+	switch {
+	case size < 1024:
+		return fmt.Sprintf("%d B", size)
+	case size < 1024*1024:
+		return fmt.Sprintf("%.1f KB", float64(size)/1024)
+	case size < 1024*1024*1024:
+		return fmt.Sprintf("%.1f MB", float64(size)/(1024*1024))
+	default:
+		return fmt.Sprintf("%.1f GB", float64(size)/(1024*1024*1024))
+	}
+}
+
+// scanFile detects the language of one file and streams it through the line
+// counter. Only the first sniffLen bytes are buffered, for binary detection
+// and content-based language heuristics; override is a linguist-language
+// attribute that skips detection.
+func scanFile(source fileSource, tf trackedFile, detector *languageDetector, override string) fileResult {
+	lang := override
+	if lang == "" {
+		// Most files are identified by name alone, without being opened
+		lang = detector.detect(tf.path, func() []byte { return nil })
+		if lang == "" && !needsContent(tf.path) {
+			return fileResult{}
+		}
+	}
+
+	rc, err := source.open(tf)
+	if err != nil {
+		return fileResult{skipped: true}
+	}
+	defer rc.Close()
+
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(rc, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return fileResult{skipped: true}
+	}
+	head = head[:n]
+	if p, ok := parseLFSPointer(head); ok && int64(n) == tf.size {
+		// An LFS pointer committed without a matching filter attribute
+		return fileResult{lfs: true, size: p.size, lfsLocal: lfsObjectExists(p.oid)}
+	}
+	if bytes.IndexByte(head, 0) >= 0 {
+		return fileResult{skipped: lang != ""}
+	}
+
+	if override == "" && needsContent(tf.path) {
+		lang = detector.detect(tf.path, func() []byte { return head })
+	}
+	if lang == "" {
+		return fileResult{}
+	}
+
+	counts, err := analyzeSource(io.MultiReader(bytes.NewReader(head), rc), lang)
+	if err != nil {
+		return fileResult{skipped: true}
+	}
+	return fileResult{lang: lang, counts: counts}
+}
+
+// needsContent reports whether detecting the language of path depends on
+// its contents: extensionless scripts and ambiguous extensions.
+func needsContent(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case "", ".h", ".m", ".pl":
+		return true
+	}
+	return false
+}
+
+// isTestFile classifies test sources by common naming conventions.
+func isTestFile(file string) bool {
+	base := strings.ToLower(filepath.Base(file))
+	dir := strings.ToLower(filepath.Dir(file))
+	return strings.Contains(base, "_test.") ||
+		strings.Contains(base, ".test.") ||
+		strings.Contains(base, ".spec.") ||
+		strings.Contains(base, "test_") ||
+		strings.Contains(dir, "/test/") ||
+		strings.Contains(dir, "/tests/") ||
+		strings.Contains(dir, "/__tests__/") ||
+		strings.HasPrefix(dir, "test/") ||
+		strings.HasPrefix(dir, "tests/")
+}
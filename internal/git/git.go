@@ -7,7 +7,10 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/fayssal-elmofatiche/gfetch/internal/i18n"
 )
 
 type Info struct {
@@ -22,6 +25,15 @@ type Info struct {
 	RepoName          string
 	Created           string
 	GitVersion        string
+	Bare              bool   // no working tree; Status is empty
+	Shallow           bool   // history is truncated; see HistoryStart
+	HistoryStart      string // date of the oldest available commit in shallow clones
+	PartialFilter     string // object filter of a partial clone, e.g. "blob:none"
+	Worktrees         int    // working trees attached to the repository, including the main one
+	LinkedWorktree    bool   // the current working tree is a linked one (git worktree add)
+	Upstream          string // upstream of the current branch, e.g. "origin/main"
+	Ahead             int    // commits on HEAD that aren't on Upstream
+	Behind            int    // commits on Upstream that aren't on HEAD
 }
 
 type LanguageStat struct {
@@ -40,16 +52,64 @@ type HotFile struct {
 	Changes int
 }
 
+// Hotspot is a frequently changed file weighted by its size and complexity.
+type Hotspot struct {
+	Path       string
+	Changes    int
+	LOC        int
+	Complexity int
+	Score      int
+}
+
+// CoupledFiles is a pair of files that tend to change in the same commits.
+type CoupledFiles struct {
+	From       string
+	To         string
+	Support    int     // commits touching both files
+	Confidence float64 // share of From's commits that also touch To
+}
+
+type FileChurn struct {
+	Path    string
+	Added   int
+	Deleted int
+}
+
+type AuthorChurn struct {
+	Name    string
+	Added   int
+	Deleted int
+}
+
+// Churn holds line-level change statistics over the hot files window.
+type Churn struct {
+	Files         []FileChurn
+	Authors       []AuthorChurn
+	Added         int
+	Deleted       int
+	AddedWeekly   []int // lines added per week, oldest first
+	DeletedWeekly []int // lines deleted per week, oldest first
+}
+
 type BranchHealth struct {
 	TotalBranches int
-	StaleBranches int // >30 days without commits
-	AheadBehind   string
+	StaleBranches int    // >30 days without commits
+	DefaultBranch string // set when ahead/behind it was computed
+	Ahead         int
+	Behind        int
 }
 
+// Velocity trends, comparing the last four weeks with the four before.
+const (
+	TrendUp   = "up"
+	TrendDown = "down"
+	TrendFlat = "flat"
+)
+
 type Velocity struct {
-	PerWeek   float64
-	Sparkline string
-	Trend     string // "↑", "↓", "→"
+	PerWeek float64
+	Weekly  []int // commits per week over the last 8 weeks, oldest first
+	Trend   string
 }
 
 type Release struct {
@@ -72,30 +132,84 @@ func runGit(args ...string) (string, error) {
 	return strings.TrimSpace(string(out)), nil
 }
 
-func GetInfo() (Info, error) {
-	var info Info
-	var err error
+// runGitInput runs git with input on stdin, e.g. for --stdin batch commands.
+func runGitInput(input string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Stdin = strings.NewReader(input)
+	out, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
 
-	info.Branch, err = runGit("rev-parse", "--abbrev-ref", "HEAD")
+// runGitIn runs git in another repository, such as a submodule checkout.
+func runGitIn(dir string, args ...string) (string, error) {
+	return runGit(append([]string{"-C", dir}, args...)...)
+}
+
+var (
+	bareOnce sync.Once
+	bareRepo bool
+)
+
+// isBareRepo reports whether the current repository has no working tree,
+// e.g. a server-side mirror.
+func isBareRepo() bool {
+	bareOnce.Do(func() {
+		out, _ := runGit("rev-parse", "--is-bare-repository")
+		bareRepo = out == "true"
+	})
+	return bareRepo
+}
+
+// readRepoFile reads a file relative to the repository root, from the
+// working tree or, in bare repositories, from the HEAD tree.
+func readRepoFile(name string) ([]byte, error) {
+	if isBareRepo() {
+		out, err := runGit("cat-file", "blob", "HEAD:"+name)
+		return []byte(out), err
+	}
+	return os.ReadFile(filepath.Join(repoRoot(), name))
+}
+
+// repoPathExists reports whether a file or directory exists relative to the
+// repository root, in the working tree or the HEAD tree of a bare repository.
+func repoPathExists(name string) bool {
+	if isBareRepo() {
+		_, err := runGit("cat-file", "-e", "HEAD:"+name)
+		return err == nil
+	}
+	_, err := os.Stat(filepath.Join(repoRoot(), name))
+	return err == nil
+}
+
+// repoRoot finds the top of the working tree so we look for files in the
+// right place when run from a subdirectory.
+func repoRoot() string {
+	root, err := runGit("rev-parse", "--show-toplevel")
 	if err != nil {
-		return info, err
+		return "."
 	}
+	return root
+}
 
-	hash, _ := runGit("rev-parse", "HEAD")
-	if len(hash) > 7 {
-		info.CommitHash = hash[:7]
-	} else {
-		info.CommitHash = hash
+func GetInfo() (Info, error) {
+	info, err := GetBriefInfo()
+	if err != nil {
+		return info, err
 	}
 
 	info.CommitCount, _ = runGit("rev-list", "--count", "HEAD")
 	info.UserName, _ = runGit("config", "user.name")
 	info.UserEmail, _ = runGit("config", "user.email")
-	info.RemoteURL, _ = runGit("config", "--get", "remote.origin.url")
 	info.LastCommitMessage, _ = runGit("log", "-1", "--pretty=%s")
-	info.RepoName = extractRepoName(info.RemoteURL)
 	info.Created = getRepoAge()
-	info.Status = getStatusSummary()
+	if out, _ := runGit("rev-parse", "--is-shallow-repository"); out == "true" {
+		info.Shallow = true
+		info.HistoryStart = getHistoryStart()
+	}
+	info.Worktrees, info.LinkedWorktree = getWorktrees()
 	if v, err := runGit("version"); err == nil {
 		info.GitVersion = strings.TrimPrefix(v, "git version ")
 	}
@@ -103,10 +217,51 @@ func GetInfo() (Info, error) {
 	return info, nil
 }
 
+// GetHeadHash returns the abbreviated hash of HEAD, as in Info.CommitHash,
+// or "" in a repository without commits.
+func GetHeadHash() string {
+	hash, _ := runGit("rev-parse", "HEAD")
+	if len(hash) > 7 {
+		return hash[:7]
+	}
+	return hash
+}
+
+// GetBriefInfo fills in the fields of Info that don't walk history: branch,
+// head, remote, upstream and status, for one-line summaries and prompts.
+func GetBriefInfo() (Info, error) {
+	var info Info
+	var err error
+
+	info.Branch, err = runGit("rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return info, err
+	}
+
+	info.CommitHash = GetHeadHash()
+
+	info.RemoteURL, _ = runGit("config", "--get", "remote.origin.url")
+	info.RepoName = extractRepoName(info.RemoteURL)
+	info.Bare = isBareRepo()
+	if !info.Bare {
+		info.Status = getStatusSummary()
+	}
+	info.PartialFilter = getPartialFilter()
+
+	// Commits ahead of and behind the upstream branch, if there is one
+	if upstream, err := runGit("rev-parse", "--abbrev-ref", "@{upstream}"); err == nil {
+		info.Upstream = upstream
+		info.Ahead, info.Behind, _ = aheadBehind("@{upstream}")
+	}
+
+	return info, nil
+}
+
 func extractRepoName(remoteURL string) string {
 	if remoteURL == "" {
 		if dir, err := os.Getwd(); err == nil {
-			return filepath.Base(dir)
+			// Bare repositories are conventionally named "<repo>.git"
+			return strings.TrimSuffix(filepath.Base(dir), ".git")
 		}
 		return "unknown"
 	}
@@ -135,47 +290,81 @@ func extractRepoName(remoteURL string) string {
 	return u
 }
 
+// getHistoryStart returns the date of the oldest commit reachable from HEAD,
+// which in a shallow clone is the shallow boundary rather than the root.
+func getHistoryStart() string {
+	out, err := runGit("log", "--max-parents=0", "--format=%cd", "--date=short", "HEAD")
+	if err != nil || out == "" {
+		return ""
+	}
+	dates := strings.Split(out, "\n")
+	sort.Strings(dates)
+	return dates[0]
+}
+
+// getPartialFilter returns the object filter of a partial clone, or "" for
+// a complete one. Partial clones record their promisor remote in
+// extensions.partialClone; older ones only set remote.origin.promisor.
+func getPartialFilter() string {
+	remote, err := runGit("config", "extensions.partialClone")
+	if err != nil || remote == "" {
+		if promisor, _ := runGit("config", "--bool", "remote.origin.promisor"); promisor != "true" {
+			return ""
+		}
+		remote = "origin"
+	}
+	filter, _ := runGit("config", "remote."+remote+".partialclonefilter")
+	if filter == "" {
+		filter = "partial"
+	}
+	return filter
+}
+
+// getWorktrees counts the working trees of the repository and reports
+// whether the current one is a linked worktree rather than the main one.
+func getWorktrees() (int, bool) {
+	out, err := runGit("worktree", "list", "--porcelain")
+	if err != nil {
+		return 0, false
+	}
+	count := 0
+	for _, line := range strings.Split(out, "\n") {
+		if strings.HasPrefix(line, "worktree ") {
+			count++
+		}
+	}
+	out, err = runGit("rev-parse", "--path-format=absolute", "--git-dir", "--git-common-dir")
+	dirs := strings.Split(out, "\n")
+	if err != nil || len(dirs) != 2 {
+		return count, false
+	}
+	return count, dirs[0] != dirs[1]
+}
+
 func getRepoAge() string {
 	out, err := runGit("log", "--reverse", "--format=%ci", "--diff-filter=A")
 	if err != nil {
-		return "unknown"
+		return i18n.T("unknown")
 	}
 	lines := strings.Split(out, "\n")
 	if len(lines) == 0 || lines[0] == "" {
-		return "unknown"
+		return i18n.T("unknown")
 	}
 	t, err := time.Parse("2006-01-02 15:04:05 -0700", lines[0])
 	if err != nil {
-		return "unknown"
+		return i18n.T("unknown")
 	}
 	return timeAgo(t)
 }
 
 func timeAgo(t time.Time) string {
-	dur := time.Since(t)
-	days := int(dur.Hours() / 24)
-	switch {
-	case days < 1:
-		return "today"
-	case days == 1:
-		return "1 day ago"
-	case days < 30:
-		return fmt.Sprintf("%d days ago", days)
-	case days < 60:
-		return "1 month ago"
-	case days < 365:
-		return fmt.Sprintf("%d months ago", days/30)
-	case days < 730:
-		return "1 year ago"
-	default:
-		return fmt.Sprintf("%d years ago", days/365)
-	}
+	return i18n.TimeAgo(t)
 }
 
 func getStatusSummary() string {
 	out, _ := runGit("status", "--short")
 	if out == "" {
-		return "clean"
+		return i18n.T("clean")
 	}
 	lines := strings.Split(out, "\n")
 	modified, added, deleted, untracked := 0, 0, 0, 0
@@ -197,199 +386,19 @@ func getStatusSummary() string {
 	}
 	var parts []string
 	if modified > 0 {
-		parts = append(parts, fmt.Sprintf("%d modified", modified))
+		parts = append(parts, i18n.N(modified, "%d modified", "%d modified"))
 	}
 	if added > 0 {
-		parts = append(parts, fmt.Sprintf("%d added", added))
+		parts = append(parts, i18n.N(added, "%d added", "%d added"))
 	}
 	if deleted > 0 {
-		parts = append(parts, fmt.Sprintf("%d deleted", deleted))
+		parts = append(parts, i18n.N(deleted, "%d deleted", "%d deleted"))
 	}
 	if untracked > 0 {
-		parts = append(parts, fmt.Sprintf("%d untracked", untracked))
-	}
-	return strings.Join(parts, ", ")
-}
-
-// Language color mapping
-var languageColors = map[string]string{
-	"Go":         "#00ADD8",
-	"Python":     "#3572A5",
-	"JavaScript": "#F7DF1E",
-	"TypeScript": "#3178C6",
-	"Rust":       "#DEA584",
-	"Java":       "#B07219",
-	"C":          "#555555",
-	"C++":        "#F34B7D",
-	"C#":         "#239120",
-	"Ruby":       "#CC342D",
-	"PHP":        "#4F5D95",
-	"Swift":      "#FA7343",
-	"Kotlin":     "#A97BFF",
-	"Shell":      "#89E051",
-	"HTML":       "#E34C26",
-	"CSS":        "#563D7C",
-	"Lua":        "#000080",
-	"Dart":       "#00B4AB",
-	"Zig":        "#EC915C",
-	"Haskell":    "#5E5086",
-	"Elixir":     "#6E4A7E",
-	"Scala":      "#DC322F",
-	"Other":      "#8B8B8B",
-}
-
-var extToLang = map[string]string{
-	".go":    "Go",
-	".py":    "Python",
-	".js":    "JavaScript",
-	".ts":    "TypeScript",
-	".tsx":   "TypeScript",
-	".jsx":   "JavaScript",
-	".rs":    "Rust",
-	".java":  "Java",
-	".c":     "C",
-	".h":     "C",
-	".cpp":   "C++",
-	".cc":    "C++",
-	".hpp":   "C++",
-	".cs":    "C#",
-	".rb":    "Ruby",
-	".php":   "PHP",
-	".swift": "Swift",
-	".kt":    "Kotlin",
-	".sh":    "Shell",
-	".bash":  "Shell",
-	".zsh":   "Shell",
-	".html":  "HTML",
-	".css":   "CSS",
-	".scss":  "CSS",
-	".lua":   "Lua",
-	".dart":  "Dart",
-	".zig":   "Zig",
-	".hs":    "Haskell",
-	".ex":    "Elixir",
-	".exs":   "Elixir",
-	".scala": "Scala",
-}
-
-// CodeStats holds all file-derived metrics computed in a single git ls-files pass.
-type CodeStats struct {
-	Languages []LanguageStat
-	Size      string
-	FileCount int
-	LOC       int
-	TestRatio TestRatio
-}
-
-// GetCodeStats enumerates tracked files once and computes language stats,
-// repo size, lines of code, and test ratio in a single pass.
-func GetCodeStats() CodeStats {
-	out, err := runGit("ls-files")
-	if err != nil {
-		return CodeStats{Size: "0 B"}
-	}
-	files := strings.Split(strings.TrimSpace(out), "\n")
-	if len(files) == 1 && files[0] == "" {
-		return CodeStats{Size: "0 B"}
-	}
-
-	var totalSize int64
-	langBytes := make(map[string]int64)
-	var totalCodeBytes int64
-	var totalLOC int
-	var codeLines, testLines int
-
-	for _, file := range files {
-		if file == "" {
-			continue
-		}
-
-		info, err := os.Stat(file)
-		if err != nil {
-			continue
-		}
-		totalSize += info.Size()
-
-		ext := strings.ToLower(filepath.Ext(file))
-		lang, isCode := extToLang[ext]
-		if !isCode {
-			continue
-		}
-
-		size := info.Size()
-		langBytes[lang] += size
-		totalCodeBytes += size
-
-		data, err := os.ReadFile(file)
-		if err != nil {
-			continue
-		}
-		lines := strings.Count(string(data), "\n")
-		if len(data) > 0 && data[len(data)-1] != '\n' {
-			lines++
-		}
-		totalLOC += lines
-
-		// Test classification
-		base := strings.ToLower(filepath.Base(file))
-		dir := strings.ToLower(filepath.Dir(file))
-		isTest := strings.Contains(base, "_test.") ||
-			strings.Contains(base, ".test.") ||
-			strings.Contains(base, ".spec.") ||
-			strings.Contains(base, "test_") ||
-			strings.Contains(dir, "/test/") ||
-			strings.Contains(dir, "/tests/") ||
-			strings.Contains(dir, "/__tests__/") ||
-			strings.HasPrefix(dir, "test/") ||
-			strings.HasPrefix(dir, "tests/")
-
-		if isTest {
-			testLines += lines
-		} else {
-			codeLines += lines
-		}
-	}
-
-	// Format size
-	var sizeStr string
-	switch {
-	case totalSize < 1024:
-		sizeStr = fmt.Sprintf("%d B", totalSize)
-	case totalSize < 1024*1024:
-		sizeStr = fmt.Sprintf("%.1f KB", float64(totalSize)/1024)
-	default:
-		sizeStr = fmt.Sprintf("%.1f MB", float64(totalSize)/(1024*1024))
-	}
-
-	// Language stats
-	var stats []LanguageStat
-	if totalCodeBytes > 0 {
-		for lang, bytes := range langBytes {
-			pct := float64(bytes) / float64(totalCodeBytes) * 100
-			color := languageColors[lang]
-			if color == "" {
-				color = languageColors["Other"]
-			}
-			stats = append(stats, LanguageStat{Name: lang, Percentage: pct, Color: color})
-		}
-		sort.Slice(stats, func(i, j int) bool {
-			return stats[i].Percentage > stats[j].Percentage
-		})
-	}
-
-	// Test ratio
-	ratio := 0.0
-	if codeLines > 0 {
-		ratio = float64(testLines) / float64(codeLines)
+		parts = append(parts, i18n.N(untracked, "%d untracked", "%d untracked"))
 	}
 
-	return CodeStats{
-		Languages: stats,
-		Size:      sizeStr,
-		FileCount: len(files),
-		LOC:       totalLOC,
-		TestRatio: TestRatio{CodeLines: codeLines, TestLines: testLines, Ratio: ratio},
-	}
+	return strings.Join(parts, ", ")
 }
 
 // ContributorStats holds the top contributors and total contributor count.
@@ -432,52 +441,172 @@ func GetContributors(max int) ContributorStats {
 func GetLastActivity() string {
 	out, err := runGit("log", "-1", "--format=%ci")
 	if err != nil {
-		return "unknown"
+		return i18n.T("unknown")
 	}
 	t, err := time.Parse("2006-01-02 15:04:05 -0700", out)
 	if err != nil {
-		return "unknown"
+		return i18n.T("unknown")
 	}
 	return timeAgo(t)
 }
 
-var generatedFiles = map[string]bool{
-	"Cargo.lock":        true,
-	"package-lock.json": true,
-	"yarn.lock":         true,
-	"pnpm-lock.yaml":   true,
-	"go.sum":            true,
-	"Gemfile.lock":      true,
-	"composer.lock":     true,
-	"Pipfile.lock":      true,
-	"poetry.lock":       true,
+// getChangeSets returns the files touched by each commit in the last 90
+// days, optionally only by author. Merge commits list no files and are
+// skipped.
+func getChangeSets(author string) [][]string {
+	args := []string{"log", "--since=90 days ago", "--pretty=format:", "--name-only"}
+	args = append(args, authorFilter(author)...)
+	out, err := runGit(args...)
+	if err != nil {
+		return nil
+	}
+
+	// Each commit's file list is separated from the next by a blank line
+	var sets [][]string
+	var current []string
+	seen := make(map[string]bool)
+	var paths []string
+	for _, line := range strings.Split(out, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			if len(current) > 0 {
+				sets = append(sets, current)
+				current = nil
+			}
+			continue
+		}
+		current = append(current, line)
+		if !seen[line] {
+			seen[line] = true
+			paths = append(paths, line)
+		}
+	}
+	if len(current) > 0 {
+		sets = append(sets, current)
+	}
+
+	// Drop vendored, generated and documentation files
+	classifier := newPathClassifier(paths, true)
+	filtered := sets[:0]
+	for _, set := range sets {
+		kept := set[:0]
+		for _, file := range set {
+			if !classifier.excluded(file) {
+				kept = append(kept, file)
+			}
+		}
+		if len(kept) > 0 {
+			filtered = append(filtered, kept)
+		}
+	}
+	return filtered
+}
+
+// authorFilter returns git log arguments limiting commits to an author as
+// named by git shortlog, or none for an empty name.
+func authorFilter(author string) []string {
+	if author == "" {
+		return nil
+	}
+	return []string{"--fixed-strings", "--author=" + author + " <"}
 }
 
-func isGenerated(path string) bool {
-	base := filepath.Base(path)
-	if generatedFiles[base] {
-		return true
+// hotFileCounts counts how often each file changed in the last 90 days.
+func hotFileCounts(author string) map[string]int {
+	sets := getChangeSets(author)
+	if sets == nil {
+		return nil
 	}
-	if strings.HasSuffix(base, "-hashes.json") || strings.HasSuffix(base, ".min.js") || strings.HasSuffix(base, ".min.css") {
-		return true
+
+	counts := make(map[string]int)
+	for _, set := range sets {
+		for _, file := range set {
+			counts[file]++
+		}
 	}
-	return false
+	return counts
 }
 
-func GetHotFiles(max int) []HotFile {
-	// Most frequently changed files in the last 90 days
-	out, err := runGit("log", "--since=90 days ago", "--pretty=format:", "--name-only")
-	if err != nil {
+// CouplingOptions holds the thresholds a file pair must meet to be reported.
+type CouplingOptions struct {
+	MinSupport    int     // minimum number of commits touching both files
+	MinConfidence float64 // minimum share of one file's commits that also touch the other
+}
+
+// maxCouplingCommitSize skips sweeping commits (renames, reformatting,
+// vendoring) that would otherwise couple every file they touch.
+const maxCouplingCommitSize = 50
+
+// GetCoupledFiles finds pairs of files that are frequently changed in the
+// same commits over the last 90 days. Confidence is reported for the
+// stronger direction: the share of From's commits that also touch To.
+func GetCoupledFiles(opts CouplingOptions, max int) []CoupledFiles {
+	sets := getChangeSets("")
+	if len(sets) == 0 {
 		return nil
 	}
 
+	type pair struct{ a, b string }
 	counts := make(map[string]int)
-	for _, line := range strings.Split(out, "\n") {
-		line = strings.TrimSpace(line)
-		if line == "" || isGenerated(line) {
+	support := make(map[pair]int)
+	for _, set := range sets {
+		for _, file := range set {
+			counts[file]++
+		}
+		if len(set) < 2 || len(set) > maxCouplingCommitSize {
 			continue
 		}
-		counts[line]++
+		sorted := append([]string(nil), set...)
+		sort.Strings(sorted)
+		for i := 0; i < len(sorted); i++ {
+			for j := i + 1; j < len(sorted); j++ {
+				if sorted[i] != sorted[j] {
+					support[pair{sorted[i], sorted[j]}]++
+				}
+			}
+		}
+	}
+
+	var result []CoupledFiles
+	for p, n := range support {
+		if n < opts.MinSupport {
+			continue
+		}
+		from, to := p.a, p.b
+		if counts[p.b] < counts[p.a] {
+			from, to = p.b, p.a
+		}
+		confidence := float64(n) / float64(counts[from])
+		if confidence < opts.MinConfidence {
+			continue
+		}
+		result = append(result, CoupledFiles{From: from, To: to, Support: n, Confidence: confidence})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Support != result[j].Support {
+			return result[i].Support > result[j].Support
+		}
+		if result[i].Confidence != result[j].Confidence {
+			return result[i].Confidence > result[j].Confidence
+		}
+		return result[i].From+result[i].To < result[j].From+result[j].To
+	})
+	if len(result) > max {
+		result = result[:max]
+	}
+	return result
+}
+
+func GetHotFiles(max int) []HotFile {
+	return GetAuthorHotFiles("", max)
+}
+
+// GetAuthorHotFiles is GetHotFiles limited to commits by author.
+func GetAuthorHotFiles(author string, max int) []HotFile {
+	// Most frequently changed files in the last 90 days
+	counts := hotFileCounts(author)
+	if counts == nil {
+		return nil
 	}
 
 	type kv struct {
@@ -502,6 +631,189 @@ func GetHotFiles(max int) []HotFile {
 	return result
 }
 
+// GetHotspots ranks source files by change frequency over the last 90 days
+// multiplied by their indentation complexity, so that large, frequently
+// edited code outranks changelogs and config files. File metrics come from
+// the CodeStats pass; files it didn't measure are ignored.
+func GetHotspots(stats CodeStats, max int) []Hotspot {
+	if len(stats.Files) == 0 {
+		return nil
+	}
+	counts := hotFileCounts("")
+	if len(counts) == 0 {
+		return nil
+	}
+
+	// git log reports paths relative to the repo root, ls-files relative to cwd
+	prefix, _ := runGit("rev-parse", "--show-prefix")
+
+	var hotspots []Hotspot
+	for file, m := range stats.Files {
+		changes := counts[prefix+file]
+		if changes == 0 || m.Complexity == 0 {
+			continue
+		}
+		hotspots = append(hotspots, Hotspot{
+			Path:       file,
+			Changes:    changes,
+			LOC:        m.LOC,
+			Complexity: m.Complexity,
+			Score:      changes * m.Complexity,
+		})
+	}
+	sort.Slice(hotspots, func(i, j int) bool {
+		if hotspots[i].Score != hotspots[j].Score {
+			return hotspots[i].Score > hotspots[j].Score
+		}
+		return hotspots[i].Path < hotspots[j].Path
+	})
+	if len(hotspots) > max {
+		hotspots = hotspots[:max]
+	}
+	return hotspots
+}
+
+// GetChurn sums lines added and deleted per file and per author over the
+// last 90 days, with weekly totals of additions and deletions.
+func GetChurn(max int) Churn {
+	out, err := runGit("log", "--since=90 days ago", "--no-merges", "--numstat", "--pretty=format:%x00%an%x00%ct")
+	if err != nil || out == "" {
+		return Churn{}
+	}
+
+	const weeks = 13
+	var churn Churn
+	var weeklyAdded, weeklyDeleted [weeks]int
+	files := make(map[string]*FileChurn)
+	authors := make(map[string]*AuthorChurn)
+	now := time.Now()
+
+	lines := strings.Split(out, "\n")
+	seen := make(map[string]bool)
+	var paths []string
+	for _, line := range lines {
+		if parts := strings.SplitN(line, "\t", 3); len(parts) == 3 {
+			if path := numstatPath(parts[2]); !seen[path] {
+				seen[path] = true
+				paths = append(paths, path)
+			}
+		}
+	}
+	classifier := newPathClassifier(paths, true)
+
+	author := ""
+	week := -1
+	for _, line := range lines {
+		if strings.HasPrefix(line, "\x00") {
+			// Commit header: \x00author\x00timestamp
+			parts := strings.SplitN(line[1:], "\x00", 2)
+			author = parts[0]
+			week = -1
+			if len(parts) == 2 {
+				var ts int64
+				fmt.Sscanf(parts[1], "%d", &ts)
+				week = weeks - 1 - int(now.Sub(time.Unix(ts, 0)).Hours()/(24*7))
+			}
+			continue
+		}
+
+		// Format: "added\tdeleted\tpath" ("-\t-\tpath" for binary files)
+		parts := strings.SplitN(line, "\t", 3)
+		if len(parts) != 3 || parts[0] == "-" {
+			continue
+		}
+		path := numstatPath(parts[2])
+		if classifier.excluded(path) {
+			continue
+		}
+		added, deleted := 0, 0
+		fmt.Sscanf(parts[0], "%d", &added)
+		fmt.Sscanf(parts[1], "%d", &deleted)
+
+		f, ok := files[path]
+		if !ok {
+			f = &FileChurn{Path: path}
+			files[path] = f
+		}
+		f.Added += added
+		f.Deleted += deleted
+
+		a, ok := authors[author]
+		if !ok {
+			a = &AuthorChurn{Name: author}
+			authors[author] = a
+		}
+		a.Added += added
+		a.Deleted += deleted
+
+		churn.Added += added
+		churn.Deleted += deleted
+		if week >= 0 && week < weeks {
+			weeklyAdded[week] += added
+			weeklyDeleted[week] += deleted
+		}
+	}
+
+	for _, f := range files {
+		// Pure renames and mode changes show up as 0 0
+		if f.Added+f.Deleted > 0 {
+			churn.Files = append(churn.Files, *f)
+		}
+	}
+	sort.Slice(churn.Files, func(i, j int) bool {
+		ci := churn.Files[i].Added + churn.Files[i].Deleted
+		cj := churn.Files[j].Added + churn.Files[j].Deleted
+		if ci != cj {
+			return ci > cj
+		}
+		return churn.Files[i].Path < churn.Files[j].Path
+	})
+	if len(churn.Files) > max {
+		churn.Files = churn.Files[:max]
+	}
+
+	for _, a := range authors {
+		if a.Added+a.Deleted > 0 {
+			churn.Authors = append(churn.Authors, *a)
+		}
+	}
+	sort.Slice(churn.Authors, func(i, j int) bool {
+		ci := churn.Authors[i].Added + churn.Authors[i].Deleted
+		cj := churn.Authors[j].Added + churn.Authors[j].Deleted
+		if ci != cj {
+			return ci > cj
+		}
+		return churn.Authors[i].Name < churn.Authors[j].Name
+	})
+	if len(churn.Authors) > max {
+		churn.Authors = churn.Authors[:max]
+	}
+
+	churn.AddedWeekly = weeklyAdded[:]
+	churn.DeletedWeekly = weeklyDeleted[:]
+
+	return churn
+}
+
+// numstatPath resolves the destination path of a --numstat rename entry,
+// e.g. "old => new" or "dir/{old => new}/file".
+func numstatPath(path string) string {
+	if !strings.Contains(path, " => ") {
+		return path
+	}
+	if open := strings.Index(path, "{"); open >= 0 {
+		if end := strings.Index(path[open:], "}"); end >= 0 {
+			inner := path[open+1 : open+end]
+			if idx := strings.Index(inner, " => "); idx >= 0 {
+				inner = inner[idx+4:]
+			}
+			joined := path[:open] + inner + path[open+end+1:]
+			return strings.ReplaceAll(joined, "//", "/")
+		}
+	}
+	return path[strings.Index(path, " => ")+4:]
+}
+
 func GetVelocity() Velocity {
 	// Get weekly commit counts for the last 8 weeks
 	var weeklyCounts []int
@@ -526,28 +838,8 @@ func GetVelocity() Velocity {
 	}
 	avg := float64(total) / float64(len(weeklyCounts))
 
-	// Build sparkline
-	sparkChars := []rune{'▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
-	maxCount := 0
-	for _, c := range weeklyCounts {
-		if c > maxCount {
-			maxCount = c
-		}
-	}
-	var spark strings.Builder
-	for _, c := range weeklyCounts {
-		idx := 0
-		if maxCount > 0 {
-			idx = int(float64(c) / float64(maxCount) * 7)
-			if idx > 7 {
-				idx = 7
-			}
-		}
-		spark.WriteRune(sparkChars[idx])
-	}
-
 	// Trend: compare last 4 weeks vs first 4 weeks
-	trend := "→"
+	trend := TrendFlat
 	if len(weeklyCounts) == 8 {
 		firstHalf := 0
 		secondHalf := 0
@@ -556,22 +848,16 @@ func GetVelocity() Velocity {
 			secondHalf += weeklyCounts[i+4]
 		}
 		if secondHalf > firstHalf+2 {
-			trend = "↑"
+			trend = TrendUp
 		} else if firstHalf > secondHalf+2 {
-			trend = "↓"
+			trend = TrendDown
 		}
 	}
 
-	return Velocity{PerWeek: avg, Sparkline: spark.String(), Trend: trend}
+	return Velocity{PerWeek: avg, Weekly: weeklyCounts, Trend: trend}
 }
 
 func GetDependencyCount() (string, int) {
-	// Find repo root so we look for dep files in the right place
-	root, err := runGit("rev-parse", "--show-toplevel")
-	if err != nil {
-		root = "."
-	}
-
 	// Detect package manager and count dependencies
 	depFiles := []struct {
 		file    string
@@ -589,8 +875,7 @@ func GetDependencyCount() (string, int) {
 	}
 
 	for _, dep := range depFiles {
-		path := filepath.Join(root, dep.file)
-		if data, err := os.ReadFile(path); err == nil {
+		if data, err := readRepoFile(dep.file); err == nil {
 			count := dep.counter(string(data))
 			if count > 0 {
 				return dep.manager, count
@@ -737,12 +1022,8 @@ func GetBranchHealth() BranchHealth {
 	defaultBranch := getDefaultBranch()
 	currentBranch, _ := runGit("rev-parse", "--abbrev-ref", "HEAD")
 	if defaultBranch != "" && currentBranch != defaultBranch {
-		out, err = runGit("rev-list", "--left-right", "--count", defaultBranch+"...HEAD")
-		if err == nil {
-			parts := strings.Fields(out)
-			if len(parts) == 2 {
-				health.AheadBehind = fmt.Sprintf("↑%s ↓%s vs %s", parts[1], parts[0], defaultBranch)
-			}
+		if ahead, behind, ok := aheadBehind(defaultBranch); ok {
+			health.DefaultBranch, health.Ahead, health.Behind = defaultBranch, ahead, behind
 		}
 	}
 
@@ -765,14 +1046,9 @@ func getDefaultBranch() string {
 }
 
 func GetLicense() string {
-	root, err := runGit("rev-parse", "--show-toplevel")
-	if err != nil {
-		root = "."
-	}
-
 	licenseFiles := []string{"LICENSE", "LICENSE.md", "LICENSE.txt", "LICENCE", "LICENCE.md", "COPYING", "COPYING.md"}
 	for _, name := range licenseFiles {
-		data, err := os.ReadFile(filepath.Join(root, name))
+		data, err := readRepoFile(name)
 		if err != nil {
 			continue
 		}
@@ -820,29 +1096,73 @@ func CleanURL(raw string) string {
 	return u
 }
 
-func GetCommitDates() ([]string, error) {
-	out, err := runGit("log", "--pretty=format:%cd", "--date=short")
+// Heatmap metrics, what GetDailyActivity totals per day.
+const (
+	MetricCommits = "commits"
+	MetricLines   = "lines"   // lines added plus deleted
+	MetricAuthors = "authors" // distinct authors
+)
+
+// GetDailyActivity totals metric per commit date (YYYY-MM-DD), limited to
+// commits by author unless author is "", and to commits from since on
+// unless it is zero.
+func GetDailyActivity(metric, author string, since time.Time) (map[string]int, error) {
+	var format []string
+	switch metric {
+	case MetricLines:
+		format = []string{"--no-merges", "--numstat", "--pretty=format:%x00%cd"}
+	case MetricAuthors:
+		format = []string{"--pretty=format:%cd%x00%aN"}
+	default:
+		format = []string{"--pretty=format:%cd"}
+	}
+	args := append(append([]string{"log", "--date=short"}, format...), authorFilter(author)...)
+	if !since.IsZero() {
+		// A day early: --since compares timestamps, not local dates
+		args = append(args, "--since="+since.AddDate(0, 0, -1).Format("2006-01-02"))
+	}
+	out, err := runGit(args...)
 	if err != nil {
 		return nil, err
 	}
 
-	lines := strings.Split(out, "\n")
-	var dates []string
-	for _, line := range lines {
-		if line != "" {
-			dates = append(dates, line)
+	days := make(map[string]int)
+	seen := make(map[string]bool) // date and author pairs
+	date := ""
+	for _, line := range strings.Split(out, "\n") {
+		if line == "" {
+			continue
+		}
+		switch metric {
+		case MetricLines:
+			if strings.HasPrefix(line, "\x00") {
+				date = line[1:]
+				continue
+			}
+			// Format: "added\tdeleted\tpath" ("-\t-\tpath" for binary files)
+			parts := strings.SplitN(line, "\t", 3)
+			if len(parts) != 3 || parts[0] == "-" {
+				continue
+			}
+			added, deleted := 0, 0
+			fmt.Sscanf(parts[0], "%d", &added)
+			fmt.Sscanf(parts[1], "%d", &deleted)
+			days[date] += added + deleted
+		case MetricAuthors:
+			if !seen[line] {
+				seen[line] = true
+				day, _, _ := strings.Cut(line, "\x00")
+				days[day]++
+			}
+		default:
+			days[line]++
 		}
 	}
-	return dates, nil
+	return days, nil
 }
 
 // GetCICD detects CI/CD configuration files in the repo
 func GetCICD() []string {
-	root, err := runGit("rev-parse", "--show-toplevel")
-	if err != nil {
-		root = "."
-	}
-
 	ciSystems := []struct {
 		path string
 		name string
@@ -868,8 +1188,7 @@ func GetCICD() []string {
 	seen := make(map[string]bool)
 	var detected []string
 	for _, ci := range ciSystems {
-		path := filepath.Join(root, ci.path)
-		if _, err := os.Stat(path); err == nil {
+		if repoPathExists(ci.path) {
 			if !seen[ci.name] {
 				seen[ci.name] = true
 				detected = append(detected, ci.name)
@@ -911,6 +1230,39 @@ func GetRecentReleases(max int) []Release {
 	return releases
 }
 
+// Commit is a commit listed in a release.
+type Commit struct {
+	Hash    string
+	Subject string
+	Author  string
+}
+
+// maxReleaseCommits bounds the commit list of a release, e.g. a first tag
+// on a long history.
+const maxReleaseCommits = 500
+
+// GetReleaseCommits lists the commits in tag since the previous tag, or
+// since the beginning of history for the first one.
+func GetReleaseCommits(tag string) []Commit {
+	rng := tag
+	if prev, err := runGit("describe", "--tags", "--abbrev=0", tag+"^"); err == nil && prev != "" {
+		rng = prev + ".." + tag
+	}
+	out, err := runGit("log", fmt.Sprintf("-%d", maxReleaseCommits), "--no-merges", "--pretty=format:%h%x00%an%x00%s", rng)
+	if err != nil || out == "" {
+		return nil
+	}
+	var commits []Commit
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.SplitN(line, "\x00", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		commits = append(commits, Commit{Hash: fields[0], Author: fields[1], Subject: fields[2]})
+	}
+	return commits
+}
+
 // GetStashCount returns the number of stashed changes
 func GetStashCount() int {
 	out, err := runGit("stash", "list")
@@ -983,3 +1335,94 @@ func GetCommitConvention() string {
 	}
 	return "Freeform"
 }
+
+// RepoDirs locates the parts of a repository that change as it is used.
+type RepoDirs struct {
+	Root      string // top of the working tree, empty for bare repositories
+	GitDir    string // HEAD and the index of this working tree
+	CommonDir string // refs, packed-refs and objects, shared by all worktrees
+}
+
+// GetRepoDirs returns absolute paths of the repository directories.
+func GetRepoDirs() (RepoDirs, error) {
+	out, err := runGit("rev-parse", "--path-format=absolute", "--git-dir", "--git-common-dir")
+	dirs := strings.Split(out, "\n")
+	if err != nil || len(dirs) != 2 {
+		return RepoDirs{}, fmt.Errorf("not a git repository")
+	}
+	rd := RepoDirs{GitDir: dirs[0], CommonDir: dirs[1]}
+	if !isBareRepo() {
+		rd.Root = repoRoot()
+	}
+	return rd, nil
+}
+
+// GetLogoConfig returns the custom logo set with the gfetch.logo and
+// gfetch.logoColors config keys. A relative path is resolved against the
+// top of the working tree.
+func GetLogoConfig() (path, colors string) {
+	path, _ = runGit("config", "--type=path", "--get", "gfetch.logo")
+	colors, _ = runGit("config", "--get", "gfetch.logoColors")
+	if path != "" && !filepath.IsAbs(path) && !isBareRepo() {
+		path = filepath.Join(repoRoot(), path)
+	}
+	return path, colors
+}
+
+// GetChangedFiles lists modified and untracked files in the working tree,
+// relative to the repository root.
+func GetChangedFiles() []string {
+	// Porcelain v2 entries start with their type, so runGit's trimming
+	// can't eat a leading status column
+	out, err := runGit("status", "--porcelain=v2", "-z", "--untracked-files=all")
+	if err != nil {
+		return nil
+	}
+	var files []string
+	entries := strings.Split(out, "\x00")
+	for i := 0; i < len(entries); i++ {
+		entry := entries[i]
+		if entry == "" {
+			continue
+		}
+		switch entry[0] {
+		case '1': // "1 XY sub mH mI mW hH hI <path>"
+			if fields := strings.SplitN(entry, " ", 9); len(fields) == 9 {
+				files = append(files, fields[8])
+			}
+		case '2': // "2 XY sub mH mI mW hH hI Xscore <path>", then the source path
+			if fields := strings.SplitN(entry, " ", 10); len(fields) == 10 {
+				files = append(files, fields[9])
+			}
+			i++
+		case 'u': // "u XY sub m1 m2 m3 mW h1 h2 h3 <path>"
+			if fields := strings.SplitN(entry, " ", 11); len(fields) == 11 {
+				files = append(files, fields[10])
+			}
+		case '?': // "? <path>"
+			files = append(files, entry[2:])
+		}
+	}
+	return files
+}
+
+// GetTrackedDirs lists the directories of the working tree holding tracked
+// files, relative to the repository root, starting with "." for the root.
+func GetTrackedDirs() []string {
+	if isBareRepo() {
+		return nil
+	}
+	out, err := runGitIn(repoRoot(), "ls-files", "-z")
+	if err != nil {
+		return nil
+	}
+	seen := map[string]bool{".": true}
+	dirs := []string{"."}
+	for _, path := range strings.Split(out, "\x00") {
+		for dir := filepath.Dir(path); !seen[dir]; dir = filepath.Dir(dir) {
+			seen[dir] = true
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
@@ -0,0 +1,388 @@
+package git
+
+import (
+	"bytes"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Language color mapping
+var languageColors = map[string]string{
+	"Go":            "#00ADD8",
+	"Python":        "#3572A5",
+	"JavaScript":    "#F7DF1E",
+	"TypeScript":    "#3178C6",
+	"Rust":          "#DEA584",
+	"Java":          "#B07219",
+	"C":             "#555555",
+	"C++":           "#F34B7D",
+	"C#":            "#239120",
+	"Ruby":          "#CC342D",
+	"PHP":           "#4F5D95",
+	"Swift":         "#FA7343",
+	"Kotlin":        "#A97BFF",
+	"Shell":         "#89E051",
+	"HTML":          "#E34C26",
+	"CSS":           "#563D7C",
+	"Lua":           "#000080",
+	"Dart":          "#00B4AB",
+	"Zig":           "#EC915C",
+	"Haskell":       "#5E5086",
+	"Elixir":        "#6E4A7E",
+	"Scala":         "#DC322F",
+	"Vue":           "#41B883",
+	"Svelte":        "#FF3E00",
+	"Astro":         "#FF5A03",
+	"Terraform":     "#7B42BC",
+	"HCL":           "#844FBA",
+	"Protobuf":      "#4285F4",
+	"GraphQL":       "#E10098",
+	"Objective-C":   "#438EFF",
+	"Objective-C++": "#6866FB",
+	"MATLAB":        "#E16737",
+	"Perl":          "#0298C3",
+	"Prolog":        "#74283C",
+	"R":             "#198CE7",
+	"Julia":         "#A270BA",
+	"OCaml":         "#EF7A08",
+	"F#":            "#B845FC",
+	"Elm":           "#60B5CC",
+	"Clojure":       "#DB5855",
+	"Erlang":        "#B83998",
+	"Nix":           "#7E7EFF",
+	"Nim":           "#FFC200",
+	"Crystal":       "#000100",
+	"Groovy":        "#4298B8",
+	"PowerShell":    "#012456",
+	"Batchfile":     "#C1F12E",
+	"SQL":           "#E38C00",
+	"Makefile":      "#427819",
+	"Dockerfile":    "#384D54",
+	"CMake":         "#DA3434",
+	"Starlark":      "#76D275",
+	"GLSL":          "#5686A5",
+	"Assembly":      "#6E4C13",
+	"Fortran":       "#4D41B1",
+	"Solidity":      "#AA6746",
+	"Visual Basic":  "#945DB7",
+	"Other":         "#8B8B8B",
+}
+
+var extToLang = map[string]string{
+	".go":      "Go",
+	".py":      "Python",
+	".pyi":     "Python",
+	".pyw":     "Python",
+	".js":      "JavaScript",
+	".mjs":     "JavaScript",
+	".cjs":     "JavaScript",
+	".jsx":     "JavaScript",
+	".ts":      "TypeScript",
+	".tsx":     "TypeScript",
+	".mts":     "TypeScript",
+	".cts":     "TypeScript",
+	".rs":      "Rust",
+	".java":    "Java",
+	".c":       "C",
+	".h":       "C", // see detectHeader
+	".cpp":     "C++",
+	".cc":      "C++",
+	".cxx":     "C++",
+	".c++":     "C++",
+	".hpp":     "C++",
+	".hh":      "C++",
+	".hxx":     "C++",
+	".ipp":     "C++",
+	".inl":     "C++",
+	".tpp":     "C++",
+	".cs":      "C#",
+	".rb":      "Ruby",
+	".rake":    "Ruby",
+	".gemspec": "Ruby",
+	".php":     "PHP",
+	".swift":   "Swift",
+	".kt":      "Kotlin",
+	".kts":     "Kotlin",
+	".sh":      "Shell",
+	".bash":    "Shell",
+	".zsh":     "Shell",
+	".ksh":     "Shell",
+	".fish":    "Shell",
+	".html":    "HTML",
+	".htm":     "HTML",
+	".xhtml":   "HTML",
+	".css":     "CSS",
+	".scss":    "CSS",
+	".sass":    "CSS",
+	".less":    "CSS",
+	".lua":     "Lua",
+	".dart":    "Dart",
+	".zig":     "Zig",
+	".hs":      "Haskell",
+	".lhs":     "Haskell",
+	".ex":      "Elixir",
+	".exs":     "Elixir",
+	".scala":   "Scala",
+	".sc":      "Scala",
+	".vue":     "Vue",
+	".svelte":  "Svelte",
+	".astro":   "Astro",
+	".tf":      "Terraform",
+	".tfvars":  "Terraform",
+	".hcl":     "HCL",
+	".proto":   "Protobuf",
+	".graphql": "GraphQL",
+	".gql":     "GraphQL",
+	".m":       "Objective-C", // see detectDotM
+	".mm":      "Objective-C++",
+	".pl":      "Perl", // see detectDotPl
+	".pm":      "Perl",
+	".r":       "R",
+	".jl":      "Julia",
+	".ml":      "OCaml",
+	".mli":     "OCaml",
+	".fs":      "F#",
+	".fsi":     "F#",
+	".fsx":     "F#",
+	".elm":     "Elm",
+	".clj":     "Clojure",
+	".cljs":    "Clojure",
+	".cljc":    "Clojure",
+	".erl":     "Erlang",
+	".hrl":     "Erlang",
+	".nix":     "Nix",
+	".nim":     "Nim",
+	".cr":      "Crystal",
+	".groovy":  "Groovy",
+	".gradle":  "Groovy",
+	".ps1":     "PowerShell",
+	".psm1":    "PowerShell",
+	".bat":     "Batchfile",
+	".cmd":     "Batchfile",
+	".sql":     "SQL",
+	".mk":      "Makefile",
+	".cmake":   "CMake",
+	".bzl":     "Starlark",
+	".star":    "Starlark",
+	".glsl":    "GLSL",
+	".vert":    "GLSL",
+	".frag":    "GLSL",
+	".asm":     "Assembly",
+	".s":       "Assembly",
+	".f":       "Fortran",
+	".f90":     "Fortran",
+	".f95":     "Fortran",
+	".sol":     "Solidity",
+	".vb":      "Visual Basic",
+}
+
+// filenameToLang maps well-known extensionless (or special) file names.
+var filenameToLang = map[string]string{
+	"makefile":       "Makefile",
+	"gnumakefile":    "Makefile",
+	"dockerfile":     "Dockerfile",
+	"containerfile":  "Dockerfile",
+	"cmakelists.txt": "CMake",
+	"rakefile":       "Ruby",
+	"gemfile":        "Ruby",
+	"podfile":        "Ruby",
+	"vagrantfile":    "Ruby",
+	"brewfile":       "Ruby",
+	"jenkinsfile":    "Groovy",
+	"build":          "Starlark",
+	"build.bazel":    "Starlark",
+	"workspace":      "Starlark",
+	"tiltfile":       "Starlark",
+	".bashrc":        "Shell",
+	".zshrc":         "Shell",
+	".profile":       "Shell",
+	".bash_profile":  "Shell",
+}
+
+// interpreterToLang maps shebang interpreters to languages.
+var interpreterToLang = map[string]string{
+	"sh":         "Shell",
+	"bash":       "Shell",
+	"zsh":        "Shell",
+	"ksh":        "Shell",
+	"dash":       "Shell",
+	"ash":        "Shell",
+	"fish":       "Shell",
+	"python":     "Python",
+	"pypy":       "Python",
+	"node":       "JavaScript",
+	"nodejs":     "JavaScript",
+	"deno":       "JavaScript",
+	"bun":        "JavaScript",
+	"ts-node":    "TypeScript",
+	"tsx":        "TypeScript",
+	"ruby":       "Ruby",
+	"perl":       "Perl",
+	"php":        "PHP",
+	"lua":        "Lua",
+	"luajit":     "Lua",
+	"rscript":    "R",
+	"julia":      "Julia",
+	"elixir":     "Elixir",
+	"escript":    "Erlang",
+	"runhaskell": "Haskell",
+	"pwsh":       "PowerShell",
+	"swift":      "Swift",
+	"groovy":     "Groovy",
+	"make":       "Makefile",
+	"ocaml":      "OCaml",
+	"scala":      "Scala",
+	"crystal":    "Crystal",
+}
+
+// languageDetector identifies the language of a tracked file from its name,
+// its shebang line, or content heuristics for ambiguous extensions. It is
+// built from the full file list so that, for example, .h headers in a
+// project with C++ sources count as C++.
+type languageDetector struct {
+	hasCpp  bool
+	hasC    bool
+	hasObjC bool
+}
+
+func newLanguageDetector(files []string) *languageDetector {
+	d := &languageDetector{}
+	for _, f := range files {
+		switch extToLang[strings.ToLower(filepath.Ext(f))] {
+		case "C++":
+			d.hasCpp = true
+		case "C":
+			if !strings.HasSuffix(f, ".h") {
+				d.hasC = true
+			}
+		case "Objective-C", "Objective-C++":
+			d.hasObjC = true
+		}
+	}
+	return d
+}
+
+// detect returns the language of path, or "" if it isn't source code.
+// content is only called for shebangs and ambiguous extensions.
+func (d *languageDetector) detect(path string, content func() []byte) string {
+	lower := strings.ToLower(filepath.Base(path))
+	if strings.HasPrefix(lower, "dockerfile.") || strings.HasSuffix(lower, ".dockerfile") {
+		return "Dockerfile"
+	}
+
+	ext := filepath.Ext(lower)
+	if ext == "" {
+		// A shebang wins over names like "build" that are also common scripts
+		if lang := detectShebang(content()); lang != "" {
+			return lang
+		}
+		return filenameToLang[lower]
+	}
+	if lang, ok := filenameToLang[lower]; ok {
+		return lang
+	}
+
+	switch ext {
+	case ".h":
+		return d.detectHeader(content())
+	case ".m":
+		return detectDotM(content())
+	case ".pl":
+		return detectDotPl(content())
+	}
+	return extToLang[ext]
+}
+
+// detectShebang reads the interpreter from a "#!" line, handling
+// "/usr/bin/env [-S] name" and versioned names like python3.12.
+func detectShebang(data []byte) string {
+	if !bytes.HasPrefix(data, []byte("#!")) {
+		return ""
+	}
+	line := data[2:]
+	if i := bytes.IndexByte(line, '\n'); i >= 0 {
+		line = line[:i]
+	}
+	fields := strings.Fields(string(line))
+	if len(fields) == 0 {
+		return ""
+	}
+	interp := filepath.Base(fields[0])
+	if interp == "env" {
+		interp = ""
+		args := fields[1:]
+		for i := 0; i < len(args); i++ {
+			f := args[i]
+			// -u NAME, -C DIR and -P PATH take a separate argument
+			switch f {
+			case "-u", "--unset", "-C", "--chdir", "-P":
+				i++
+				continue
+			}
+			if strings.HasPrefix(f, "-") || strings.Contains(f, "=") {
+				continue
+			}
+			interp = filepath.Base(f)
+			break
+		}
+	}
+	interp = strings.ToLower(strings.TrimRight(interp, "0123456789."))
+	return interpreterToLang[interp]
+}
+
+var (
+	cppHeaderPattern  = regexp.MustCompile(`(?m)^\s*(class\s+\w+\s*[:{]|namespace\s+\w*\s*\{|template\s*<|#include\s*<(iostream|string|vector|memory|map)>)|std::`)
+	objcHeaderPattern = regexp.MustCompile(`(?m)^\s*(@interface|@protocol|@property|#import)\b`)
+)
+
+// detectHeader decides between C, C++ and Objective-C for .h files, first
+// by content and then by which sources the project contains.
+func (d *languageDetector) detectHeader(data []byte) string {
+	switch {
+	case objcHeaderPattern.Match(data):
+		return "Objective-C"
+	case cppHeaderPattern.Match(data):
+		return "C++"
+	case d.hasCpp && !d.hasC:
+		return "C++"
+	case d.hasObjC && !d.hasC:
+		return "Objective-C"
+	}
+	return "C"
+}
+
+var (
+	objcPattern   = regexp.MustCompile(`(?m)^\s*(#import|#include|@interface|@implementation|@protocol)\b`)
+	matlabPattern = regexp.MustCompile(`(?m)^\s*(function\b.*=|%|end\s*$)`)
+)
+
+// detectDotM decides between Objective-C and MATLAB for .m files.
+func detectDotM(data []byte) string {
+	if objcPattern.Match(data) {
+		return "Objective-C"
+	}
+	if matlabPattern.Match(data) {
+		return "MATLAB"
+	}
+	return "Objective-C"
+}
+
+var (
+	perlPattern   = regexp.MustCompile(`(?m)^\s*(use\s+(strict|warnings)|my\s+[$@%]|sub\s+\w+|package\s+\w+)`)
+	prologPattern = regexp.MustCompile(`(?m)(^:-|:-\s*$|^\w+\(.*\)\s*:-)`)
+)
+
+// detectDotPl decides between Perl and Prolog for .pl files.
+func detectDotPl(data []byte) string {
+	if bytes.HasPrefix(data, []byte("#!")) && bytes.Contains(data[:min(len(data), 64)], []byte("perl")) {
+		return "Perl"
+	}
+	if perlPattern.Match(data) {
+		return "Perl"
+	}
+	if prologPattern.Match(data) {
+		return "Prolog"
+	}
+	return "Perl"
+}
@@ -0,0 +1,104 @@
+package git
+
+import "testing"
+
+func TestDetectShebang(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{"#!/bin/sh\n", "Shell"},
+		{"#!/usr/bin/env python3\n", "Python"},
+		{"#!/usr/bin/python3.11 -u\n", "Python"},
+		{"#!/usr/bin/env -S deno run --allow-net\n", "JavaScript"},
+		{"#!/usr/bin/env -S NODE_OPTIONS=--no-warnings node\n", "JavaScript"},
+		{"#!/usr/bin/env -i PATH=/usr/bin perl -w\n", "Perl"},
+		{"#!/usr/bin/env -u LANG ruby\n", "Ruby"},
+		{"#!/usr/bin/env --chdir /tmp bash\n", "Shell"},
+		{"#! /usr/bin/env  lua5.4\n", "Lua"},
+		{"#!/usr/bin/env\n", ""},
+		{"#!/usr/bin/env -S\n", ""},
+		{"#!\n", ""},
+		{"#!/opt/unknown\n", ""},
+		{"echo '#!/bin/sh'\n", ""},
+	}
+	for _, tt := range tests {
+		if got := detectShebang([]byte(tt.src)); got != tt.want {
+			t.Errorf("detectShebang(%q) = %q, want %q", tt.src, got, tt.want)
+		}
+	}
+}
+
+func TestDetectHeader(t *testing.T) {
+	tests := []struct {
+		name  string
+		files []string
+		src   string
+		want  string
+	}{
+		{"plain C", []string{"a.c", "a.h"}, "int add(int a, int b);\n", "C"},
+		{"class", []string{"a.c", "a.h"}, "class Widget {\npublic:\n};\n", "C++"},
+		{"namespace", nil, "namespace app {\n}\n", "C++"},
+		{"template", nil, "template <typename T>\nT max(T a, T b);\n", "C++"},
+		{"std", nil, "void log(const std::string &msg);\n", "C++"},
+		{"interface", nil, "@interface Widget : NSObject\n@end\n", "Objective-C"},
+		{"import", nil, "#import <Foundation/Foundation.h>\n", "Objective-C"},
+		{"C++ project", []string{"a.cpp", "a.h"}, "int add(int a, int b);\n", "C++"},
+		{"Objective-C project", []string{"a.m", "a.h"}, "int add(int a, int b);\n", "Objective-C"},
+		{"mixed project", []string{"a.c", "b.cpp", "a.h"}, "int add(int a, int b);\n", "C"},
+		{"no sources", []string{"a.h"}, "int add(int a, int b);\n", "C"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newLanguageDetector(tt.files)
+			if got := d.detectHeader([]byte(tt.src)); got != tt.want {
+				t.Errorf("detectHeader(%q) = %q, want %q", tt.src, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectDotM(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{"implementation", "#import \"Widget.h\"\n\n@implementation Widget\n@end\n", "Objective-C"},
+		{"include", "#include <stdio.h>\n", "Objective-C"},
+		{"function", "function y = square(x)\n  y = x .^ 2;\nend\n", "MATLAB"},
+		{"script", "% compute the mean\nm = mean(data);\n", "MATLAB"},
+		{"empty", "", "Objective-C"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := detectDotM([]byte(tt.src)); got != tt.want {
+				t.Errorf("detectDotM(%q) = %q, want %q", tt.src, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectDotPl(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{"shebang", "#!/usr/bin/perl\nprint \"hi\\n\";\n", "Perl"},
+		{"env shebang", "#!/usr/bin/env perl\n", "Perl"},
+		{"pragmas", "use strict;\nuse warnings;\n", "Perl"},
+		{"sub", "sub greet {\n  print \"hi\";\n}\n", "Perl"},
+		{"rules", "parent(tom, bob).\nancestor(X, Y) :- parent(X, Y).\n", "Prolog"},
+		{"directive", ":- module(family, [parent/2]).\n", "Prolog"},
+		{"multiline rule", "grandparent(X, Z) :-\n    parent(X, Y),\n    parent(Y, Z).\n", "Prolog"},
+		{"neither", "print 'hi';\n", "Perl"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := detectDotPl([]byte(tt.src)); got != tt.want {
+				t.Errorf("detectDotPl(%q) = %q, want %q", tt.src, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// LFSStats summarizes files stored in Git LFS.
+type LFSStats struct {
+	Files int
+	Size  string // total logical size, from pointer "size" fields
+	Local int    // objects present in the local LFS store
+}
+
+// lfsPointerMaxSize is the largest a pointer file can be, per the spec:
+// https://github.com/git-lfs/git-lfs/blob/main/docs/spec.md
+const lfsPointerMaxSize = 1024
+
+var lfsPointerHeader = []byte("version https://git-lfs.github.com/spec/v1")
+
+// lfsPointer is the parsed content of an LFS pointer file.
+type lfsPointer struct {
+	oid  string // sha256 hex
+	size int64
+}
+
+// parseLFSPointer parses data as an LFS pointer file, which needs both an
+// oid and a numeric size.
+func parseLFSPointer(data []byte) (lfsPointer, bool) {
+	if len(data) > lfsPointerMaxSize || !bytes.HasPrefix(data, lfsPointerHeader) {
+		return lfsPointer{}, false
+	}
+	var p lfsPointer
+	sized := false
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		key, value, _ := strings.Cut(scanner.Text(), " ")
+		switch key {
+		case "oid":
+			p.oid = strings.TrimPrefix(value, "sha256:")
+		case "size":
+			size, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || size < 0 {
+				return lfsPointer{}, false
+			}
+			p.size, sized = size, true
+		}
+	}
+	if p.oid == "" || !sized {
+		return lfsPointer{}, false
+	}
+	return p, true
+}
+
+// lfsFile measures one LFS-tracked file, whichever form it takes in source:
+// a pointer (index, HEAD, or a worktree without the LFS filter installed)
+// or smudged content (a regular worktree checkout). Either way the
+// reported size is the logical size of the real content.
+func lfsFile(source fileSource, tf trackedFile) (size int64, local bool) {
+	if tf.size > lfsPointerMaxSize {
+		return tf.size, true // smudged content, so it is present
+	}
+	rc, err := source.open(tf)
+	if err != nil {
+		return tf.size, false
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(io.LimitReader(rc, lfsPointerMaxSize+1))
+	if err != nil {
+		return tf.size, false
+	}
+	p, ok := parseLFSPointer(data)
+	if !ok {
+		return tf.size, true
+	}
+	return p.size, lfsObjectExists(p.oid)
+}
+
+var (
+	lfsStoreOnce sync.Once
+	lfsStore     string
+)
+
+// lfsObjectExists reports whether an LFS object is in the local store,
+// <git-common-dir>/lfs/objects/ab/cd/abcd..., or under lfs.storage, which
+// git-lfs resolves against the git directory when it's relative.
+func lfsObjectExists(oid string) bool {
+	lfsStoreOnce.Do(func() {
+		gitDir, err := runGit("rev-parse", "--path-format=absolute", "--git-common-dir")
+		if err != nil {
+			return
+		}
+		if dir, err := runGit("config", "lfs.storage"); err == nil && dir != "" {
+			if !filepath.IsAbs(dir) {
+				dir = filepath.Join(gitDir, dir)
+			}
+			lfsStore = filepath.Join(dir, "objects")
+			return
+		}
+		lfsStore = filepath.Join(gitDir, "lfs", "objects")
+	})
+	if lfsStore == "" || len(oid) < 4 {
+		return false
+	}
+	_, err := os.Stat(filepath.Join(lfsStore, oid[0:2], oid[2:4], oid))
+	return err == nil
+}
@@ -0,0 +1,65 @@
+package git
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseLFSPointer(t *testing.T) {
+	const oid = "4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393"
+	tests := []struct {
+		name   string
+		data   string
+		want   lfsPointer
+		wantOK bool
+	}{
+		{
+			name:   "pointer",
+			data:   "version https://git-lfs.github.com/spec/v1\noid sha256:" + oid + "\nsize 12345\n",
+			want:   lfsPointer{oid: oid, size: 12345},
+			wantOK: true,
+		},
+		{
+			name:   "extension lines",
+			data:   "version https://git-lfs.github.com/spec/v1\next-0-foo sha256:" + oid + "\noid sha256:" + oid + "\nsize 0\n",
+			want:   lfsPointer{oid: oid},
+			wantOK: true,
+		},
+		{
+			name: "not a pointer",
+			data: "package main\n\nfunc main() {}\n",
+		},
+		{
+			name: "empty",
+			data: "",
+		},
+		{
+			name: "missing oid",
+			data: "version https://git-lfs.github.com/spec/v1\nsize 12345\n",
+		},
+		{
+			name: "missing size",
+			data: "version https://git-lfs.github.com/spec/v1\noid sha256:" + oid + "\n",
+		},
+		{
+			name: "non-numeric size",
+			data: "version https://git-lfs.github.com/spec/v1\noid sha256:" + oid + "\nsize 12kb\n",
+		},
+		{
+			name: "negative size",
+			data: "version https://git-lfs.github.com/spec/v1\noid sha256:" + oid + "\nsize -1\n",
+		},
+		{
+			name: "oversize",
+			data: "version https://git-lfs.github.com/spec/v1\noid sha256:" + oid + "\nsize 1\n" + strings.Repeat("x", lfsPointerMaxSize),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseLFSPointer([]byte(tt.data))
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("parseLFSPointer() = %+v, %v, want %+v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
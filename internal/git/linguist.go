@@ -0,0 +1,197 @@
+package git
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// Linguist attributes read from .gitattributes, following GitHub Linguist:
+// https://github.com/github-linguist/linguist/blob/main/docs/overrides.md
+const (
+	attrVendored      = "linguist-vendored"
+	attrGenerated     = "linguist-generated"
+	attrDocumentation = "linguist-documentation"
+	attrLanguage      = "linguist-language"
+	attrFilter        = "filter" // "lfs" for Git LFS tracked files
+)
+
+var generatedFiles = map[string]bool{
+	"Cargo.lock":        true,
+	"package-lock.json": true,
+	"yarn.lock":         true,
+	"pnpm-lock.yaml":    true,
+	"go.sum":            true,
+	"Gemfile.lock":      true,
+	"composer.lock":     true,
+	"Pipfile.lock":      true,
+	"poetry.lock":       true,
+	"flake.lock":        true,
+	"mix.lock":          true,
+	"pubspec.lock":      true,
+}
+
+var generatedSuffixes = []string{
+	"-hashes.json",
+	".min.js",
+	".min.css",
+	".js.map",
+	".css.map",
+	".pb.go",
+	".pb.gw.go",
+	".pb.cc",
+	".pb.h",
+	"_pb2.py",
+	"_pb2_grpc.py",
+	"_pb.js",
+	"_pb.d.ts",
+	".g.dart",
+	".freezed.dart",
+	".designer.cs",
+	"_generated.go",
+}
+
+// vendoredDirs are path segments holding third-party code.
+var vendoredDirs = map[string]bool{
+	"vendor":           true,
+	"vendors":          true,
+	"node_modules":     true,
+	"bower_components": true,
+	"third_party":      true,
+	"third-party":      true,
+	"thirdparty":       true,
+	"3rdparty":         true,
+	"external":         true,
+	"Godeps":           true,
+	"Pods":             true,
+	"Carthage":         true,
+	".yarn":            true,
+}
+
+// documentationDirs are path segments holding docs and examples.
+var documentationDirs = map[string]bool{
+	"doc":           true,
+	"docs":          true,
+	"Documentation": true,
+	"documentation": true,
+	"examples":      true,
+	"samples":       true,
+}
+
+func isGenerated(path string) bool {
+	base := filepath.Base(path)
+	if generatedFiles[base] {
+		return true
+	}
+	if strings.HasPrefix(base, "zz_generated.") {
+		return true
+	}
+	for _, suffix := range generatedSuffixes {
+		if strings.HasSuffix(base, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isVendored(path string) bool {
+	return hasDirSegment(path, vendoredDirs)
+}
+
+func isDocumentation(path string) bool {
+	return hasDirSegment(path, documentationDirs)
+}
+
+// hasDirSegment reports whether any directory in path is one of dirs.
+func hasDirSegment(path string, dirs map[string]bool) bool {
+	parts := strings.Split(filepath.ToSlash(path), "/")
+	for _, part := range parts[:len(parts)-1] {
+		if dirs[part] {
+			return true
+		}
+	}
+	return false
+}
+
+// pathClassifier decides which paths count towards language stats, LOC,
+// test ratio and hot files, and which are stored in Git LFS. .gitattributes
+// linguist overrides take precedence over the built-in path heuristics in
+// both directions, so "linguist-vendored=false" re-includes a vendor/
+// directory.
+type pathClassifier struct {
+	attrs map[string]map[string]string
+}
+
+// newPathClassifier reads linguist attributes for paths in one
+// git check-attr call. fromRoot marks paths as relative to the repository
+// root (as git log prints them) rather than the working directory.
+func newPathClassifier(paths []string, fromRoot bool) *pathClassifier {
+	c := &pathClassifier{attrs: make(map[string]map[string]string)}
+	if len(paths) == 0 {
+		return c
+	}
+
+	args := []string{"check-attr", "--stdin", "-z", attrVendored, attrGenerated, attrDocumentation, attrLanguage, attrFilter}
+	if fromRoot {
+		cdup, _ := runGit("rev-parse", "--show-cdup")
+		if cdup != "" {
+			args = append([]string{"-C", cdup}, args...)
+		}
+	}
+	out, err := runGitInput(strings.Join(paths, "\x00"), args...)
+	if err != nil {
+		return c
+	}
+
+	// Output: path NUL attribute NUL value NUL, repeated
+	fields := strings.Split(out, "\x00")
+	for i := 0; i+2 < len(fields); i += 3 {
+		path, attr, value := fields[i], fields[i+1], fields[i+2]
+		if value == "unspecified" {
+			continue
+		}
+		if c.attrs[path] == nil {
+			c.attrs[path] = make(map[string]string)
+		}
+		c.attrs[path][attr] = value
+	}
+	return c
+}
+
+// flag resolves a boolean linguist attribute, falling back to heuristic
+// when the attribute is unspecified.
+func (c *pathClassifier) flag(path, attr string, heuristic func(string) bool) bool {
+	switch c.attrs[path][attr] {
+	case "set", "true":
+		return true
+	case "unset", "false":
+		return false
+	}
+	return heuristic(path)
+}
+
+// excluded reports whether path is vendored, generated or documentation.
+func (c *pathClassifier) excluded(path string) bool {
+	return c.flag(path, attrVendored, isVendored) ||
+		c.flag(path, attrGenerated, isGenerated) ||
+		c.flag(path, attrDocumentation, isDocumentation)
+}
+
+// lfs reports whether path is tracked by Git LFS.
+func (c *pathClassifier) lfs(path string) bool {
+	return c.attrs[path][attrFilter] == "lfs"
+}
+
+// language returns the linguist-language override for path, if any,
+// normalized to gfetch's language names.
+func (c *pathClassifier) language(path string) string {
+	lang := c.attrs[path][attrLanguage]
+	if lang == "" || lang == "set" || lang == "unset" {
+		return ""
+	}
+	for name := range languageColors {
+		if strings.EqualFold(name, lang) {
+			return name
+		}
+	}
+	return lang
+}
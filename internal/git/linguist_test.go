@@ -0,0 +1,105 @@
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+const testAttributes = `vendor/keep/** -linguist-vendored
+third_party/** linguist-vendored=false
+lib/bundled.js linguist-vendored
+src/gen/** linguist-generated
+go.sum -linguist-generated
+docs/api/** -linguist-documentation
+notes/** linguist-documentation
+vendor/mixed/** -linguist-vendored linguist-generated
+*.inc linguist-language=php
+*.tpl linguist-language=HTML
+*.tmpl linguist-language=Templ
+*.bin filter=lfs
+`
+
+// inTestRepo runs the test from the root of a new repository with the
+// given .gitattributes and a src subdirectory.
+func inTestRepo(t *testing.T, attributes string) string {
+	t.Helper()
+	dir := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", dir).CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v\n%s", err, out)
+	}
+	if err := os.WriteFile(filepath.Join(dir, ".gitattributes"), []byte(attributes), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(dir, "src"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+	return dir
+}
+
+func TestPathClassifier(t *testing.T) {
+	inTestRepo(t, testAttributes)
+	tests := []struct {
+		path     string
+		excluded bool
+		language string
+		lfs      bool
+	}{
+		{path: "src/main.go"},
+		{path: "vendor/lib/a.go", excluded: true},
+		{path: "vendor/keep/a.go"},
+		{path: "third_party/x.c"},
+		{path: "lib/bundled.js", excluded: true},
+		{path: "src/gen/a.go", excluded: true},
+		{path: "app.min.js", excluded: true},
+		{path: "go.sum"},
+		{path: "docs/guide.md", excluded: true},
+		{path: "docs/api/a.go"},
+		{path: "notes/a.go", excluded: true},
+		{path: "vendor/mixed/a.go", excluded: true},
+		{path: "src/page.inc", language: "PHP"},
+		{path: "src/page.tpl", language: "HTML"},
+		{path: "src/page.tmpl", language: "Templ"},
+		{path: "vendor/page.inc", excluded: true, language: "PHP"},
+		{path: "assets/model.bin", lfs: true},
+	}
+	var paths []string
+	for _, tt := range tests {
+		paths = append(paths, tt.path)
+	}
+	c := newPathClassifier(paths, false)
+	for _, tt := range tests {
+		if got := c.excluded(tt.path); got != tt.excluded {
+			t.Errorf("excluded(%q) = %v, want %v", tt.path, got, tt.excluded)
+		}
+		if got := c.language(tt.path); got != tt.language {
+			t.Errorf("language(%q) = %q, want %q", tt.path, got, tt.language)
+		}
+		if got := c.lfs(tt.path); got != tt.lfs {
+			t.Errorf("lfs(%q) = %v, want %v", tt.path, got, tt.lfs)
+		}
+	}
+}
+
+func TestPathClassifierFromRoot(t *testing.T) {
+	dir := inTestRepo(t, testAttributes)
+	if err := os.Chdir(filepath.Join(dir, "src")); err != nil {
+		t.Fatal(err)
+	}
+	c := newPathClassifier([]string{"vendor/keep/a.go", "src/gen/a.go"}, true)
+	if c.excluded("vendor/keep/a.go") {
+		t.Error("excluded(vendor/keep/a.go) = true from a subdirectory, want false")
+	}
+	if !c.excluded("src/gen/a.go") {
+		t.Error("excluded(src/gen/a.go) = false from a subdirectory, want true")
+	}
+}
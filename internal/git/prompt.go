@@ -0,0 +1,108 @@
+package git
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Prompt fields, as accepted by GetPrompt.
+const (
+	PromptBranch   = "branch"
+	PromptUpstream = "upstream" // commits ahead of and behind the upstream branch
+	PromptStatus   = "status"
+	PromptStash    = "stash"
+)
+
+// Prompt is the repository state shown in a shell prompt segment. Fields
+// that weren't requested or didn't finish in time are left empty.
+type Prompt struct {
+	Branch string
+	Ahead  int
+	Behind int
+	Status string // as in Info.Status
+	Stash  int
+}
+
+// GetPrompt collects the requested fields concurrently and returns what is
+// ready when timeout expires, so a slow repository delays the prompt by at
+// most timeout. Unknown fields are ignored.
+func GetPrompt(fields []string, timeout time.Duration) Prompt {
+	collectors := map[string]func() func(*Prompt){
+		PromptBranch: func() func(*Prompt) {
+			branch, err := runGit("rev-parse", "--abbrev-ref", "HEAD")
+			if branch == "HEAD" {
+				// Detached: show the commit instead
+				branch, err = runGit("rev-parse", "--short", "HEAD")
+			}
+			if err != nil {
+				return nil
+			}
+			return func(p *Prompt) { p.Branch = branch }
+		},
+		PromptUpstream: func() func(*Prompt) {
+			ahead, behind, ok := aheadBehind("@{upstream}")
+			if !ok {
+				return nil
+			}
+			return func(p *Prompt) { p.Ahead, p.Behind = ahead, behind }
+		},
+		PromptStatus: func() func(*Prompt) {
+			if isBareRepo() {
+				return nil
+			}
+			status := getStatusSummary()
+			return func(p *Prompt) { p.Status = status }
+		},
+		PromptStash: func() func(*Prompt) {
+			stash := GetStashCount()
+			return func(p *Prompt) { p.Stash = stash }
+		},
+	}
+
+	// Results are applied by this goroutine only; late ones are dropped.
+	// Git processes still running are left to finish rather than killed,
+	// so they can't leave a stale index.lock behind.
+	results := make(chan func(*Prompt), len(fields))
+	pending := 0
+	for _, field := range fields {
+		collect, ok := collectors[strings.TrimSpace(field)]
+		if !ok {
+			continue
+		}
+		pending++
+		go func() {
+			results <- collect()
+		}()
+	}
+
+	var p Prompt
+	deadline := time.After(timeout)
+	for ; pending > 0; pending-- {
+		select {
+		case apply := <-results:
+			if apply != nil {
+				apply(&p)
+			}
+		case <-deadline:
+			return p
+		}
+	}
+	return p
+}
+
+// aheadBehind counts commits on HEAD that aren't on base and the reverse.
+// ok is false if base doesn't exist, e.g. a branch without an upstream.
+func aheadBehind(base string) (ahead, behind int, ok bool) {
+	out, err := runGit("rev-list", "--left-right", "--count", base+"...HEAD")
+	if err != nil {
+		return 0, 0, false
+	}
+	parts := strings.Fields(out)
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+	behind, _ = strconv.Atoi(parts[0])
+	ahead, _ = strconv.Atoi(parts[1])
+	return ahead, behind, true
+}
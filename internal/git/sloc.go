@@ -0,0 +1,287 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"unicode/utf8"
+)
+
+// LanguageLines holds code, comment and blank line counts for one language.
+type LanguageLines struct {
+	Name    string
+	Files   int
+	Code    int
+	Comment int
+	Blank   int
+}
+
+// commentSyntax describes how a language marks comments.
+type commentSyntax struct {
+	line       []string    // line comment markers
+	blocks     [][2]string // block comment start/end delimiters
+	nested     bool        // block comments nest (Rust, Haskell, ...)
+	docstrings bool        // triple-quoted strings starting a line are comments (Python)
+
+	// apostrophes means ' also marks lifetimes, type variables, primed
+	// names or prose, so it only quotes character literals like 'x'
+	apostrophes bool
+}
+
+var (
+	cSyntax      = commentSyntax{line: []string{"//"}, blocks: [][2]string{{"/*", "*/"}}}
+	nestedSyntax = commentSyntax{line: []string{"//"}, blocks: [][2]string{{"/*", "*/"}}, nested: true}
+	hashSyntax   = commentSyntax{line: []string{"#"}}
+	hclSyntax    = commentSyntax{line: []string{"#", "//"}, blocks: [][2]string{{"/*", "*/"}}}
+	webSyntax    = commentSyntax{line: []string{"//"}, blocks: [][2]string{{"<!--", "-->"}, {"/*", "*/"}}} // single-file components
+)
+
+var commentSyntaxes = map[string]commentSyntax{
+	"Go":            cSyntax,
+	"Python":        {line: []string{"#"}, docstrings: true},
+	"JavaScript":    cSyntax,
+	"TypeScript":    cSyntax,
+	"Rust":          {line: []string{"//"}, blocks: [][2]string{{"/*", "*/"}}, nested: true, apostrophes: true},
+	"Java":          cSyntax,
+	"C":             cSyntax,
+	"C++":           cSyntax,
+	"C#":            cSyntax,
+	"Ruby":          {line: []string{"#"}, blocks: [][2]string{{"=begin", "=end"}}},
+	"PHP":           {line: []string{"//", "#"}, blocks: [][2]string{{"/*", "*/"}}},
+	"Swift":         nestedSyntax,
+	"Kotlin":        nestedSyntax,
+	"Shell":         hashSyntax,
+	"HTML":          {blocks: [][2]string{{"<!--", "-->"}}, apostrophes: true},
+	"CSS":           cSyntax, // SCSS allows // line comments too
+	"Lua":           {line: []string{"--"}, blocks: [][2]string{{"--[[", "]]"}}},
+	"Dart":          nestedSyntax,
+	"Zig":           {line: []string{"//"}},
+	"Haskell":       {line: []string{"--"}, blocks: [][2]string{{"{-", "-}"}}, nested: true, apostrophes: true},
+	"Elixir":        hashSyntax,
+	"Scala":         {line: []string{"//"}, blocks: [][2]string{{"/*", "*/"}}, nested: true, apostrophes: true},
+	"Vue":           webSyntax,
+	"Svelte":        webSyntax,
+	"Astro":         webSyntax,
+	"Terraform":     hclSyntax,
+	"HCL":           hclSyntax,
+	"Protobuf":      cSyntax,
+	"GraphQL":       hashSyntax,
+	"Objective-C":   cSyntax,
+	"Objective-C++": cSyntax,
+	"MATLAB":        {line: []string{"%"}, blocks: [][2]string{{"%{", "%}"}}, apostrophes: true},
+	"Perl":          {line: []string{"#"}, blocks: [][2]string{{"=pod", "=cut"}, {"=head", "=cut"}}},
+	"Prolog":        {line: []string{"%"}, blocks: [][2]string{{"/*", "*/"}}},
+	"R":             hashSyntax,
+	"Julia":         {line: []string{"#"}, blocks: [][2]string{{"#=", "=#"}}, nested: true, docstrings: true, apostrophes: true},
+	"OCaml":         {blocks: [][2]string{{"(*", "*)"}}, nested: true, apostrophes: true},
+	"F#":            {line: []string{"//"}, blocks: [][2]string{{"(*", "*)"}}, apostrophes: true},
+	"Elm":           {line: []string{"--"}, blocks: [][2]string{{"{-", "-}"}}, nested: true, apostrophes: true},
+	"Clojure":       {line: []string{";"}, apostrophes: true},
+	"Erlang":        {line: []string{"%"}},
+	"Nix":           {line: []string{"#"}, blocks: [][2]string{{"/*", "*/"}}},
+	"Nim":           {line: []string{"#"}, blocks: [][2]string{{"#[", "]#"}}, nested: true},
+	"Crystal":       hashSyntax,
+	"Groovy":        cSyntax,
+	"PowerShell":    {line: []string{"#"}, blocks: [][2]string{{"<#", "#>"}}},
+	"Batchfile":     {line: []string{"::", "REM ", "rem ", "@REM ", "@rem "}},
+	"SQL":           {line: []string{"--"}, blocks: [][2]string{{"/*", "*/"}}},
+	"Makefile":      hashSyntax,
+	"Dockerfile":    hashSyntax,
+	"CMake":         {line: []string{"#"}, blocks: [][2]string{{"#[[", "]]"}}},
+	"Starlark":      {line: []string{"#"}, docstrings: true},
+	"GLSL":          cSyntax,
+	"Assembly":      {line: []string{";", "#"}, blocks: [][2]string{{"/*", "*/"}}},
+	"Fortran":       {line: []string{"!"}},
+	"Solidity":      cSyntax,
+	"Visual Basic":  {line: []string{"'"}},
+}
+
+// sourceCounts is the per-file result of analyzeSource.
+type sourceCounts struct {
+	code       int
+	comment    int
+	blank      int
+	complexity int // sum of indentation depth over code lines
+}
+
+// maxLineLength bounds a single line, e.g. in minified files.
+const maxLineLength = 16 << 20
+
+// analyzeSource classifies every line of a source file as code, comment or
+// blank using the comment syntax of lang, like tokei or cloc. A line holding
+// both code and a trailing comment counts as code. Strings are only tracked
+// within a single line, which is enough to keep "//" inside URLs or '/*'
+// from being mistaken for comments. The file is streamed line by line as
+// bytes; a read error, or a line longer than maxLineLength, is returned
+// with the counts so far.
+func analyzeSource(r io.Reader, lang string) (sourceCounts, error) {
+	syntax := commentSyntaxes[lang]
+	var counts sourceCounts
+
+	depth := 0            // open block comments (>1 only when nested)
+	var blockEnd []byte   // end delimiter of the open block comment
+	var blockStart []byte // start delimiter, for nesting
+	var docstring []byte  // closing delimiter of an open docstring
+
+	lineMarkers := make([][]byte, len(syntax.line))
+	for i, m := range syntax.line {
+		lineMarkers[i] = []byte(m)
+	}
+	blocks := make([][2][]byte, len(syntax.blocks))
+	for i, b := range syntax.blocks {
+		blocks[i] = [2][]byte{[]byte(b[0]), []byte(b[1])}
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxLineLength)
+	for scanner.Scan() {
+		line := scanner.Bytes() // without the trailing \r\n
+		trimmed := bytes.TrimSpace(line)
+		if len(trimmed) == 0 {
+			counts.blank++
+			continue
+		}
+
+		hasCode, hasComment := false, false
+		i := len(line) - len(bytes.TrimLeft(line, " \t"))
+
+		// Docstrings open only at the start of a statement
+		if depth == 0 && docstring == nil && syntax.docstrings {
+			for _, q := range docstringQuotes {
+				if bytes.HasPrefix(line[i:], q) {
+					docstring = q
+					i += len(q)
+					break
+				}
+			}
+		}
+
+	scan:
+		for i < len(line) {
+			switch {
+			case docstring != nil:
+				hasComment = true
+				end := bytes.Index(line[i:], docstring)
+				if end < 0 {
+					break scan
+				}
+				i += end + len(docstring)
+				docstring = nil
+
+			case depth > 0:
+				hasComment = true
+				end := bytes.Index(line[i:], blockEnd)
+				if syntax.nested {
+					if open := bytes.Index(line[i:], blockStart); open >= 0 && (end < 0 || open < end) {
+						depth++
+						i += open + len(blockStart)
+						continue
+					}
+				}
+				if end < 0 {
+					break scan
+				}
+				depth--
+				i += end + len(blockEnd)
+
+			default:
+				rest := line[i:]
+				for _, b := range blocks {
+					if bytes.HasPrefix(rest, b[0]) {
+						hasComment = true
+						depth = 1
+						blockStart, blockEnd = b[0], b[1]
+						i += len(b[0])
+						continue scan
+					}
+				}
+				for _, marker := range lineMarkers {
+					if bytes.HasPrefix(rest, marker) {
+						hasComment = true
+						break scan
+					}
+				}
+				switch c := line[i]; c {
+				case ' ', '\t', '\r':
+					i++
+				case '"', '`':
+					hasCode = true
+					i = skipString(line, i)
+				case '\'':
+					hasCode = true
+					if syntax.apostrophes {
+						i = skipCharLiteral(line, i)
+					} else {
+						i = skipString(line, i)
+					}
+				default:
+					hasCode = true
+					i++
+				}
+			}
+		}
+
+		switch {
+		case hasCode:
+			counts.code++
+			counts.complexity += indentDepth(line)
+		case hasComment:
+			counts.comment++
+		default:
+			counts.blank++
+		}
+	}
+	return counts, scanner.Err()
+}
+
+var docstringQuotes = [][]byte{[]byte(`"""`), []byte(`'''`)}
+
+// skipString returns the index just past the string literal opening at
+// line[start], or len(line) if it isn't closed on this line.
+func skipString(line []byte, start int) int {
+	quote := line[start]
+	for i := start + 1; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case quote:
+			return i + 1
+		}
+	}
+	return len(line)
+}
+
+// skipCharLiteral returns the index just past a character literal such as
+// 'x', 'é' or '\n' opening at line[start], or start+1 if the quote opens
+// none, as with a Rust lifetime or a Haskell prime.
+func skipCharLiteral(line []byte, start int) int {
+	i := start + 1
+	if i+1 < len(line) && line[i] == '\\' {
+		// Escapes are short: '\n', '\x7f', '\u{1F600}'
+		if end := bytes.IndexByte(line[i+2:], '\''); end >= 0 && end < 10 {
+			return i + 2 + end + 1
+		}
+		return i
+	}
+	if _, size := utf8.DecodeRune(line[i:]); i+size < len(line) && line[i+size] == '\'' {
+		return i + size + 1
+	}
+	return i
+}
+
+// indentDepth returns the indentation level of a line. A tab counts as one
+// level, as do four spaces.
+func indentDepth(line []byte) int {
+	spaces, tabs := 0, 0
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case ' ':
+			spaces++
+		case '\t':
+			tabs++
+		default:
+			return tabs + spaces/4
+		}
+	}
+	return tabs + spaces/4
+}
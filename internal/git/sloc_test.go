@@ -0,0 +1,140 @@
+package git
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestAnalyzeSource(t *testing.T) {
+	tests := []struct {
+		name string
+		lang string
+		src  string
+		want sourceCounts
+	}{
+		{
+			name: "line and block comments",
+			lang: "Go",
+			src:  "package main\n\n// a comment\n/* block\n   still block */\nfunc main() {}\n",
+			want: sourceCounts{code: 2, comment: 3, blank: 1},
+		},
+		{
+			name: "trailing comment counts as code",
+			lang: "Go",
+			src:  "x := 1 // one\n",
+			want: sourceCounts{code: 1},
+		},
+		{
+			name: "comment markers in double-quoted strings",
+			lang: "Go",
+			src:  "url := \"https://example.com\"\ns := \"/* not a comment\"\nx := 1\n",
+			want: sourceCounts{code: 3},
+		},
+		{
+			name: "comment markers in single-quoted strings",
+			lang: "JavaScript",
+			src:  "const a = '/*';\nconst b = 1;\nconst c = 'it\\'s // fine';\n",
+			want: sourceCounts{code: 3},
+		},
+		{
+			name: "block comment after a single-quoted string",
+			lang: "JavaScript",
+			src:  "const a = 'x'; /* open\nclosed */\n",
+			want: sourceCounts{code: 1, comment: 1},
+		},
+		{
+			name: "comment markers in character literals",
+			lang: "C",
+			src:  "char c = '/';\nchar d = '*'; /* one\ntwo */\n",
+			want: sourceCounts{code: 2, comment: 1},
+		},
+		{
+			name: "rust lifetimes don't open strings",
+			lang: "Rust",
+			src:  "fn f<'a>(x: &'a str) {} /* open\nclosed */\nlet c = '/';\nlet e = '\\'';\n",
+			want: sourceCounts{code: 3, comment: 1},
+		},
+		{
+			name: "haskell primes don't open strings",
+			lang: "Haskell",
+			src:  "x' = x {- open\nclosed -}\n",
+			want: sourceCounts{code: 1, comment: 1},
+		},
+		{
+			name: "nested block comments",
+			lang: "Rust",
+			src:  "/* outer /* inner */\nstill outer */\nfn main() {}\n",
+			want: sourceCounts{code: 1, comment: 2},
+		},
+		{
+			name: "python docstrings",
+			lang: "Python",
+			src:  "def f():\n    \"\"\"Doc\n    string.\"\"\"\n    return '#'\n# comment\n",
+			want: sourceCounts{code: 2, comment: 3, complexity: 1},
+		},
+		{
+			name: "hash comments",
+			lang: "Shell",
+			src:  "#!/bin/sh\necho '# not a comment'\n\n",
+			want: sourceCounts{code: 1, comment: 1, blank: 1},
+		},
+		{
+			name: "complexity from indentation",
+			lang: "Go",
+			src:  "func f() {\n\tif x {\n\t\treturn\n\t}\n}\n",
+			want: sourceCounts{code: 5, complexity: 4},
+		},
+		{
+			name: "crlf line endings",
+			lang: "C",
+			src:  "int x;\r\n\r\n// c\r\n",
+			want: sourceCounts{code: 1, comment: 1, blank: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := analyzeSource(strings.NewReader(tt.src), tt.lang)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("analyzeSource() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnalyzeSourceErrors(t *testing.T) {
+	long := strings.Repeat("x", maxLineLength+1)
+	if _, err := analyzeSource(strings.NewReader("x := 1\n"+long+"\n"), "Go"); err == nil {
+		t.Error("analyzeSource() with an overlong line returned no error")
+	}
+
+	failing := iotest.TimeoutReader(strings.NewReader("x := 1\ny := 2\n"))
+	if _, err := analyzeSource(iotest.OneByteReader(failing), "Go"); !errors.Is(err, iotest.ErrTimeout) {
+		t.Errorf("analyzeSource() with a failing reader = %v, want %v", err, iotest.ErrTimeout)
+	}
+}
+
+func TestSkipCharLiteral(t *testing.T) {
+	tests := []struct {
+		line string
+		want int
+	}{
+		{"'x'", 3},
+		{"'é'", 4},
+		{`'\n'`, 4},
+		{`'\''`, 4},
+		{`'\u{1F600}'`, 11},
+		{"'a>", 1},
+		{"'", 1},
+		{`'\`, 1},
+	}
+	for _, tt := range tests {
+		if got := skipCharLiteral([]byte(tt.line), 0); got != tt.want {
+			t.Errorf("skipCharLiteral(%q) = %d, want %d", tt.line, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+)
+
+// Sources GetCodeStats can read tracked files from.
+const (
+	SourceWorktree = "worktree" // files on disk, including local modifications
+	SourceIndex    = "index"    // staged blobs, via git cat-file
+	SourceHead     = "head"     // blobs in the HEAD tree, via git cat-file
+)
+
+// trackedFile is a file listed by a fileSource.
+type trackedFile struct {
+	path string
+	size int64
+	oid  string // blob id, empty for worktree files
+}
+
+// fileSource lists tracked files and opens them for reading. open must be
+// safe for concurrent use.
+type fileSource interface {
+	list() ([]trackedFile, error)
+	open(f trackedFile) (io.ReadCloser, error)
+	close()
+}
+
+// newFileSource returns the source for mode, defaulting to the worktree.
+// recurse descends into initialized submodules; it only applies to the
+// worktree, since submodule blobs live in their own object databases.
+func newFileSource(mode string, recurse bool) (fileSource, error) {
+	switch mode {
+	case SourceIndex, SourceHead:
+		cf, err := newCatFile()
+		if err != nil {
+			return nil, err
+		}
+		return &blobSource{head: mode == SourceHead, cat: cf}, nil
+	case "", SourceWorktree:
+		return worktreeSource{recurse: recurse}, nil
+	}
+	return nil, fmt.Errorf("unknown source %q", mode)
+}
+
+// worktreeSource reads tracked files from the working tree. Files missing
+// on disk (deleted, or outside a sparse checkout) are skipped, as are
+// submodule gitlinks unless recurse lists the files inside them.
+type worktreeSource struct {
+	recurse bool
+}
+
+func (s worktreeSource) list() ([]trackedFile, error) {
+	args := []string{"ls-files", "-z"}
+	if s.recurse {
+		args = append(args, "--recurse-submodules")
+	}
+	out, err := runGit(args...)
+	if err != nil {
+		return nil, err
+	}
+	var files []trackedFile
+	for _, path := range strings.Split(out, "\x00") {
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil || !info.Mode().IsRegular() {
+			continue
+		}
+		files = append(files, trackedFile{path: path, size: info.Size()})
+	}
+	return files, nil
+}
+
+func (worktreeSource) open(f trackedFile) (io.ReadCloser, error) {
+	return os.Open(f.path)
+}
+
+func (worktreeSource) close() {}
+
+// blobSource reads tracked files from the object database, either as staged
+// in the index or as committed in HEAD, so results don't depend on the state
+// of the working tree and work without one.
+type blobSource struct {
+	head bool
+	cat  *catFile
+}
+
+func (s *blobSource) list() ([]trackedFile, error) {
+	if s.head {
+		return listTree("HEAD")
+	}
+
+	// Format: "<mode> <oid> <stage>\t<path>"
+	out, err := runGit("ls-files", "-s", "-z")
+	if err != nil {
+		return nil, err
+	}
+	var files []trackedFile
+	var oids []string
+	for _, entry := range strings.Split(out, "\x00") {
+		meta, path, ok := strings.Cut(entry, "\t")
+		fields := strings.Fields(meta)
+		if !ok || len(fields) != 3 || fields[0] == "160000" || fields[2] != "0" {
+			continue // submodules and unmerged entries
+		}
+		files = append(files, trackedFile{path: path, oid: fields[1]})
+		oids = append(oids, fields[1])
+	}
+
+	// Sizes for every staged blob in one batch
+	out, err = runGitInput(strings.Join(oids, "\n"), "cat-file", "--batch-check=%(objectsize)")
+	if err != nil {
+		return nil, err
+	}
+	for i, line := range strings.Split(out, "\n") {
+		if i < len(files) {
+			fmt.Sscanf(line, "%d", &files[i].size)
+		}
+	}
+	return files, nil
+}
+
+// open reads the whole blob, since the shared cat-file stream can't be
+// handed out to concurrent readers. Callers skip oversized files first.
+func (s *blobSource) open(f trackedFile) (io.ReadCloser, error) {
+	data, err := s.cat.read(f.oid)
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (s *blobSource) close() {
+	s.cat.close()
+}
+
+// listTree lists the blobs of a tree-ish with their sizes, relative to the
+// working directory like git ls-files.
+func listTree(treeish string) ([]trackedFile, error) {
+	// Format: "<mode> <type> <oid> <size>\t<path>"
+	out, err := runGit("ls-tree", "-r", "-l", "-z", treeish)
+	if err != nil {
+		return nil, err
+	}
+	var files []trackedFile
+	for _, entry := range strings.Split(out, "\x00") {
+		meta, path, ok := strings.Cut(entry, "\t")
+		fields := strings.Fields(meta)
+		if !ok || len(fields) != 4 || fields[1] != "blob" {
+			continue
+		}
+		f := trackedFile{path: path, oid: fields[2]}
+		fmt.Sscanf(fields[3], "%d", &f.size)
+		files = append(files, f)
+	}
+	return files, nil
+}
+
+// catFile is a long-lived git cat-file --batch process, so reading many
+// blobs doesn't spawn a git process per file. It is safe for concurrent use.
+type catFile struct {
+	mu     sync.Mutex
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout *bufio.Reader
+}
+
+func newCatFile() (*catFile, error) {
+	cmd := exec.Command("git", "cat-file", "--batch")
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	return &catFile{cmd: cmd, stdin: stdin, stdout: bufio.NewReader(stdout)}, nil
+}
+
+// read returns the contents of an object by name (an oid or "<rev>:<path>").
+func (c *catFile) read(object string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, err := fmt.Fprintln(c.stdin, object); err != nil {
+		return nil, err
+	}
+
+	// Header: "<oid> <type> <size>" or "<object> missing"
+	header, err := c.stdout.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	fields := strings.Fields(header)
+	if len(fields) != 3 {
+		return nil, fmt.Errorf("cat-file: %s", strings.TrimSpace(header))
+	}
+	var size int64
+	if _, err := fmt.Sscanf(fields[2], "%d", &size); err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, size+1) // content plus trailing newline
+	if _, err := io.ReadFull(c.stdout, data); err != nil {
+		return nil, err
+	}
+	return data[:size], nil
+}
+
+func (c *catFile) close() {
+	c.stdin.Close()
+	c.cmd.Wait()
+}
@@ -0,0 +1,177 @@
+package git
+
+import (
+	"bufio"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Storage describes the object database of the repository.
+type Storage struct {
+	LooseObjects  int
+	LooseSize     string
+	PackedObjects int
+	Packs         int
+	PackSize      string
+	Garbage       int
+	LargestBlobs  []Blob
+	GC            GCReason // why "git gc" is recommended
+}
+
+// Reasons "git gc --auto" would repack.
+const (
+	GCLooseObjects = "loose"
+	GCPacks        = "packs"
+	GCGarbage      = "garbage"
+)
+
+// GCReason is the check that recommends "git gc" and the count that
+// exceeded its threshold. Kind is empty if gc isn't recommended.
+type GCReason struct {
+	Kind  string
+	Count int
+}
+
+// Blob is a file version stored anywhere in history.
+type Blob struct {
+	Path    string
+	Size    string
+	Deleted bool // the path no longer exists in HEAD
+}
+
+// Thresholds git itself uses for "git gc --auto" (gc.auto, gc.autoPackLimit).
+const (
+	defaultGCAuto      = 6700
+	defaultGCPackLimit = 50
+)
+
+// GetStorage reports object database usage from git count-objects and the
+// largest blobs reachable from any ref, including files since deleted.
+func GetStorage(max int) Storage {
+	var st Storage
+
+	// Sizes are in KiB
+	out, err := runGit("count-objects", "-v")
+	if err != nil {
+		return st
+	}
+	var looseKiB, packKiB int64
+	for _, line := range strings.Split(out, "\n") {
+		key, value, ok := strings.Cut(line, ": ")
+		if !ok {
+			continue
+		}
+		n, _ := strconv.ParseInt(value, 10, 64)
+		switch key {
+		case "count":
+			st.LooseObjects = int(n)
+		case "size":
+			looseKiB = n
+		case "in-pack":
+			st.PackedObjects = int(n)
+		case "packs":
+			st.Packs = int(n)
+		case "size-pack":
+			packKiB = n
+		case "garbage":
+			st.Garbage = int(n)
+		}
+	}
+	st.LooseSize = formatSize(looseKiB * 1024)
+	st.PackSize = formatSize(packKiB * 1024)
+	st.GC = gcReason(st)
+	st.LargestBlobs = largestBlobs(max)
+	return st
+}
+
+// gcReason mirrors the checks behind "git gc --auto".
+func gcReason(st Storage) GCReason {
+	gcAuto := defaultGCAuto
+	if v, err := runGit("config", "--int", "gc.auto"); err == nil {
+		gcAuto, _ = strconv.Atoi(v)
+	}
+	packLimit := defaultGCPackLimit
+	if v, err := runGit("config", "--int", "gc.autoPackLimit"); err == nil {
+		packLimit, _ = strconv.Atoi(v)
+	}
+
+	switch {
+	case gcAuto > 0 && st.LooseObjects > gcAuto:
+		return GCReason{GCLooseObjects, st.LooseObjects}
+	case packLimit > 0 && st.Packs > packLimit:
+		return GCReason{GCPacks, st.Packs}
+	case st.Garbage > 0:
+		return GCReason{GCGarbage, st.Garbage}
+	}
+	return GCReason{}
+}
+
+// largestBlobs pipes git rev-list --objects --all into
+// git cat-file --batch-check to size every blob in history. In partial
+// clones only blobs present locally are sized; missing ones aren't fetched.
+func largestBlobs(max int) []Blob {
+	args := []string{"rev-list", "--objects", "--all"}
+	if getPartialFilter() != "" {
+		args = append(args, "--missing=allow-promisor")
+	}
+	revList := exec.Command("git", args...)
+	batchCheck := exec.Command("git", "cat-file", "--batch-check=%(objecttype) %(objectsize) %(rest)")
+
+	pipe, err := revList.StdoutPipe()
+	if err != nil {
+		return nil
+	}
+	batchCheck.Stdin = pipe
+	stdout, err := batchCheck.StdoutPipe()
+	if err != nil {
+		return nil
+	}
+	if err := revList.Start(); err != nil {
+		return nil
+	}
+	if err := batchCheck.Start(); err != nil {
+		revList.Process.Kill()
+		revList.Wait()
+		return nil
+	}
+
+	type blob struct {
+		path string
+		size int64
+	}
+	var blobs []blob
+	scanner := bufio.NewScanner(stdout)
+	for scanner.Scan() {
+		// Format: "<type> <size> <path>"
+		fields := strings.SplitN(scanner.Text(), " ", 3)
+		if len(fields) != 3 || fields[0] != "blob" {
+			continue
+		}
+		size, _ := strconv.ParseInt(fields[1], 10, 64)
+		blobs = append(blobs, blob{path: fields[2], size: size})
+	}
+	revList.Wait()
+	batchCheck.Wait()
+
+	sort.Slice(blobs, func(i, j int) bool {
+		return blobs[i].size > blobs[j].size
+	})
+	if len(blobs) > max {
+		blobs = blobs[:max]
+	}
+
+	inHead := make(map[string]bool)
+	if out, err := runGit("ls-tree", "-r", "-z", "--full-tree", "--name-only", "HEAD"); err == nil {
+		for _, path := range strings.Split(out, "\x00") {
+			inHead[path] = true
+		}
+	}
+
+	var result []Blob
+	for _, b := range blobs {
+		result = append(result, Blob{Path: b.path, Size: formatSize(b.size), Deleted: !inHead[b.path]})
+	}
+	return result
+}
@@ -0,0 +1,156 @@
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Submodule describes a submodule declared in .gitmodules.
+type Submodule struct {
+	Name        string
+	Path        string
+	URL         string
+	Branch      string // tracked branch, from .gitmodules or the submodule's origin/HEAD; "" if none
+	Commit      string // commit pinned by the superproject (short hash)
+	Initialized bool   // checked out in the working tree
+	Behind      int    // commits on the tracked branch after Commit, per local refs
+}
+
+// GetSubmodules lists submodules with their pinned commits. Whether a
+// submodule is behind its tracked branch is computed from the submodule's
+// local remote-tracking refs only; nothing is fetched.
+func GetSubmodules() []Submodule {
+	args := []string{"config", "-z", "--get-regexp", `^submodule\..*\.(path|url|branch)$`}
+	if isBareRepo() {
+		args = append([]string{"config", "--blob", "HEAD:.gitmodules"}, args[1:]...)
+	} else {
+		args = append([]string{"config", "-f", filepath.Join(repoRoot(), ".gitmodules")}, args[1:]...)
+	}
+	out, err := runGit(args...)
+	if err != nil || out == "" {
+		return nil
+	}
+
+	// Output: "submodule.<name>.<key>\n<value>\0", repeated
+	byName := make(map[string]*Submodule)
+	for _, entry := range strings.Split(out, "\x00") {
+		key, value, ok := strings.Cut(entry, "\n")
+		if !ok {
+			continue
+		}
+		key = strings.TrimPrefix(key, "submodule.")
+		dot := strings.LastIndex(key, ".")
+		if dot < 0 {
+			continue
+		}
+		name, field := key[:dot], key[dot+1:]
+		sm, ok := byName[name]
+		if !ok {
+			sm = &Submodule{Name: name}
+			byName[name] = sm
+		}
+		switch field {
+		case "path":
+			sm.Path = value
+		case "url":
+			sm.URL = value
+		case "branch":
+			sm.Branch = value
+		}
+	}
+
+	pinned := gitlinks()
+	var subs []Submodule
+	for _, sm := range byName {
+		if sm.Path == "" {
+			continue
+		}
+		// "." tracks the branch the superproject has checked out, like
+		// git submodule update --remote; a detached HEAD tracks none
+		tracked := true
+		if sm.Branch == "." {
+			sm.Branch = superprojectBranch()
+			tracked = sm.Branch != ""
+		}
+		commit := pinned[sm.Path]
+		if len(commit) > 7 {
+			sm.Commit = commit[:7]
+		} else {
+			sm.Commit = commit
+		}
+		if !isBareRepo() {
+			dir := filepath.Join(repoRoot(), sm.Path)
+			if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+				sm.Initialized = true
+				if tracked {
+					sm.Behind = submoduleBehind(dir, commit, sm)
+				}
+			}
+		}
+		subs = append(subs, *sm)
+	}
+	sort.Slice(subs, func(i, j int) bool {
+		return subs[i].Path < subs[j].Path
+	})
+	return subs
+}
+
+// superprojectBranch returns the branch checked out in the superproject,
+// or "" if HEAD is detached.
+func superprojectBranch() string {
+	branch, _ := runGit("symbolic-ref", "--short", "-q", "HEAD")
+	return branch
+}
+
+// gitlinks maps submodule paths to the commits pinned in the index, or in
+// HEAD for bare repositories.
+func gitlinks() map[string]string {
+	links := make(map[string]string)
+	if isBareRepo() {
+		// Format: "<mode> <type> <oid>\t<path>"
+		out, _ := runGit("ls-tree", "-r", "-z", "--full-tree", "HEAD")
+		for _, entry := range strings.Split(out, "\x00") {
+			meta, path, ok := strings.Cut(entry, "\t")
+			fields := strings.Fields(meta)
+			if ok && len(fields) == 3 && fields[1] == "commit" {
+				links[path] = fields[2]
+			}
+		}
+		return links
+	}
+
+	// Format: "<mode> <oid> <stage>\t<path>"
+	out, _ := runGitIn(repoRoot(), "ls-files", "-s", "-z")
+	for _, entry := range strings.Split(out, "\x00") {
+		meta, path, ok := strings.Cut(entry, "\t")
+		fields := strings.Fields(meta)
+		if ok && len(fields) == 3 && fields[0] == "160000" {
+			links[path] = fields[1]
+		}
+	}
+	return links
+}
+
+// submoduleBehind counts commits on the submodule's tracked branch that the
+// pinned commit doesn't have yet, and fills in the branch if unset.
+func submoduleBehind(dir, commit string, sm *Submodule) int {
+	if commit == "" {
+		return 0
+	}
+	if sm.Branch == "" {
+		head, err := runGitIn(dir, "symbolic-ref", "--short", "refs/remotes/origin/HEAD")
+		if err != nil {
+			return 0
+		}
+		sm.Branch = strings.TrimPrefix(head, "origin/")
+	}
+	out, err := runGitIn(dir, "rev-list", "--count", commit+"..refs/remotes/origin/"+sm.Branch)
+	if err != nil {
+		return 0
+	}
+	n, _ := strconv.Atoi(out)
+	return n
+}
@@ -0,0 +1,589 @@
+package i18n
+
+var french = &catalog{
+	messages: map[string]string{
+		"Repository:":                    "Dépôt :",
+		"Branch:":                        "Branche :",
+		"Head:":                          "Head :",
+		"Author:":                        "Auteur :",
+		"Created:":                       "Créé :",
+		"Last active:":                   "Dernière activité :",
+		"Languages:":                     "Langages :",
+		"Size:":                          "Taille :",
+		"Lines:":                         "Lignes :",
+		"LFS:":                           "LFS :",
+		"URL:":                           "URL :",
+		"Clone:":                         "Clone :",
+		"Worktrees:":                     "Worktrees :",
+		"Authors:":                       "Auteurs :",
+		"Version:":                       "Version :",
+		"License:":                       "Licence :",
+		"Velocity:":                      "Rythme :",
+		"Deps:":                          "Dépendances :",
+		"Branches:":                      "Branches :",
+		"CI/CD:":                         "CI/CD :",
+		"Tests:":                         "Tests :",
+		"Commits:":                       "Commits :",
+		"Stash:":                         "Stash :",
+		"Git:":                           "Git :",
+		"Status:":                        "État :",
+		"Packed:":                        "Compressés :",
+		"Loose:":                         "Libres :",
+		"Maintenance:":                   "Maintenance :",
+		"Largest blobs:":                 "Plus gros blobs :",
+		"Languages":                      "Langages",
+		"Lines of Code":                  "Lignes de code",
+		"Top Authors":                    "Principaux auteurs",
+		"Hot Files":                      "Fichiers les plus modifiés",
+		"Hotspots":                       "Points chauds",
+		"Coupled Files":                  "Fichiers couplés",
+		"Churn":                          "Volume de changements",
+		"Storage":                        "Stockage",
+		"Submodules":                     "Sous-modules",
+		"Releases":                       "Versions",
+		"Commit Activity":                "Activité des commits",
+		"(90 days)":                      "(90 jours)",
+		"past year":                      "année écoulée",
+		"Less":                           "Moins",
+		"More":                           "Plus",
+		"today":                          "aujourd'hui",
+		"Month":                          "Mois",
+		"Busiest day":                    "Jour le plus actif",
+		"Busiest weekday:":               "Jour de semaine le plus actif :",
+		"by month":                       "par mois",
+		"Lines Changed":                  "Lignes modifiées",
+		"Author Activity":                "Activité des auteurs",
+		"Commits":                        "Commits",
+		"Lines":                          "Lignes",
+		"Author days":                    "Jours-auteurs",
+		"shallow, history starts":        "clone superficiel, historique depuis le",
+		"%.1f commits per week, %s":      "%.1f commits par semaine, %s",
+		"%.1f commits per week, %s (%s)": "%.1f commits par semaine, %s (%s)",
+		"%.1f/wk":                        "%.1f/sem",
+		"%5d files %8d code":             "%5d fichiers %8d code",
+		"%s and %s change together: %d shared commits, %.0f%% confidence": "%s et %s changent ensemble : %d commits communs, confiance %.0f%%",
+		"%s lines added (%s)":   "%s lignes ajoutées (%s)",
+		"%s lines deleted (%s)": "%s lignes supprimées (%s)",
+		"%s/%s panes  %s/%s move  enter select  esc back  q quit": "%s/%s onglets  %s/%s déplacer  entrée choisir  échap retour  q quitter",
+		"(%d behind %s)":                        "(%d en retard sur %s)",
+		"(%d changes, %s lines, complexity %s)": "(%d modifications, %s lignes, complexité %s)",
+		"(%d files, %d skipped)":                "(%d fichiers, %d ignorés)",
+		"(%d local)":                            "(%d en local)",
+		"(%d stale)":                            "(%d inactives)",
+		"(%d total)":                            "(%d au total)",
+		"(%s comments, %s blank)":               "(%s commentaires, %s vides)",
+		"(%s test / %s code)":                   "(%s test / %s code)",
+		"(%s, esc to go back)":                  "(%s, échap pour revenir)",
+		"(bare)":                                "(nu)",
+		"(deleted)":                             "(supprimé)",
+		"(enter to filter hot files and activity)": "(entrée pour filtrer les fichiers et l'activité)",
+		"(enter to list commits)":                  "(entrée pour lister les commits)",
+		"(enter to list files)":                    "(entrée pour lister les fichiers)",
+		"(not initialized)":                        "(non initialisé)",
+		"(this one linked)":                        "(celui-ci lié)",
+		"(up to date with %s)":                     "(à jour avec %s)",
+		", %d ahead of and %d behind %s":           ", %d en avance et %d en retard sur %s",
+		", %d ahead, %d behind":                    ", %d en avance, %d en retard",
+		"Activity":                                 "Activité",
+		"Authors":                                  "Auteurs",
+		"Blank":                                    "Vides",
+		"By %s (esc to clear)":                     "Par %s (échap pour effacer)",
+		"By author:":                               "Par auteur :",
+		"Code":                                     "Code",
+		"Comment":                                  "Commentaires",
+		"Conventional (scoped)":                    "Conventional (avec portée)",
+		"Coupled":                                  "Couplés",
+		"Custom":                                   "Personnalisée",
+		"Files":                                    "Fichiers",
+		"Freeform":                                 "Libre",
+		"LOC":                                      "lignes",
+		"Language":                                 "Langage",
+		"Loading":                                  "Chargement",
+		"No changes in the last 90 days":           "Aucune modification ces 90 derniers jours",
+		"Not a git repository":                     "Pas un dépôt git",
+		"Overview":                                 "Aperçu",
+		"Total":                                    "Total",
+		"author: %s":                               "auteur : %s",
+		"changes":                                  "modifications",
+		"clean":                                    "propre",
+		"complexity":                               "complexité",
+		"falling":                                  "en baisse",
+		"git gc recommended":                       "git gc recommandé",
+		"partial":                                  "partiel",
+		"rising":                                   "en hausse",
+		"steady":                                   "stable",
+		"times":                                    "fois",
+		"unknown":                                  "inconnu",
+		"vs":                                       "vs",
+		"weekly %s, oldest first":                  "par semaine %s, de la plus ancienne à la plus récente",
+	},
+	plurals: map[string][2]string{
+		"%d days ago":                     {"il y a %d jour", "il y a %d jours"},
+		"%d months ago":                   {"il y a %d mois", "il y a %d mois"},
+		"%d years ago":                    {"il y a %d an", "il y a %d ans"},
+		"past %d weeks":                   {"%d dernière semaine", "%d dernières semaines"},
+		"%d commits":                      {"%d commit", "%d commits"},
+		"past %d months":                  {"%d dernier mois", "%d derniers mois"},
+		"%d lines":                        {"%d ligne", "%d lignes"},
+		"%d author days":                  {"%d jour-auteur", "%d jours-auteurs"},
+		"%d added":                        {"%d ajouté", "%d ajoutés"},
+		"%d changes":                      {"%d modification", "%d modifications"},
+		"%d deleted":                      {"%d supprimé", "%d supprimés"},
+		"%d entries":                      {"%d entrée", "%d entrées"},
+		"%d modified":                     {"%d modifié", "%d modifiés"},
+		"%d objects":                      {"%d objet", "%d objets"},
+		"%d untracked":                    {"%d non suivi", "%d non suivis"},
+		"(%d files)":                      {"(%d fichier)", "(%d fichiers)"},
+		"in %d packs":                     {"dans %d pack", "dans %d packs"},
+		"%d files":                        {"%d fichier", "%d fichiers"},
+		"(%d commits)":                    {"(%d commit)", "(%d commits)"},
+		"(shallow, %d commits available)": {"(clone superficiel, %d commit disponible)", "(clone superficiel, %d commits disponibles)"},
+		"%d loose objects":                {"%d objet libre", "%d objets libres"},
+		"%d packs":                        {"%d pack", "%d packs"},
+		"%d garbage files":                {"%d fichier parasite", "%d fichiers parasites"},
+	},
+	months:   [12]string{"janv", "févr", "mars", "avr", "mai", "juin", "juil", "août", "sept", "oct", "nov", "déc"},
+	weekdays: [7]string{"dim", "lun", "mar", "mer", "jeu", "ven", "sam"},
+	singular: func(n int) bool { return n == 0 || n == 1 },
+}
+
+var german = &catalog{
+	messages: map[string]string{
+		"Author:":                        "Autor:",
+		"Created:":                       "Erstellt:",
+		"Last active:":                   "Zuletzt aktiv:",
+		"Languages:":                     "Sprachen:",
+		"Size:":                          "Größe:",
+		"Lines:":                         "Zeilen:",
+		"Clone:":                         "Klon:",
+		"Authors:":                       "Autoren:",
+		"License:":                       "Lizenz:",
+		"Velocity:":                      "Tempo:",
+		"Deps:":                          "Abhängigkeiten:",
+		"Packed:":                        "Gepackt:",
+		"Loose:":                         "Lose:",
+		"Maintenance:":                   "Wartung:",
+		"Largest blobs:":                 "Größte Blobs:",
+		"Languages":                      "Sprachen",
+		"Lines of Code":                  "Codezeilen",
+		"Top Authors":                    "Top-Autoren",
+		"Hot Files":                      "Häufig geänderte Dateien",
+		"Coupled Files":                  "Gekoppelte Dateien",
+		"Churn":                          "Änderungsvolumen",
+		"Storage":                        "Speicher",
+		"Submodules":                     "Submodule",
+		"Commit Activity":                "Commit-Aktivität",
+		"(90 days)":                      "(90 Tage)",
+		"past year":                      "letztes Jahr",
+		"Less":                           "Weniger",
+		"More":                           "Mehr",
+		"today":                          "heute",
+		"Month":                          "Monat",
+		"Busiest day":                    "Aktivster Tag",
+		"Busiest weekday:":               "Aktivster Wochentag:",
+		"by month":                       "nach Monat",
+		"Lines Changed":                  "Geänderte Zeilen",
+		"Author Activity":                "Autorenaktivität",
+		"Lines":                          "Zeilen",
+		"Author days":                    "Autorentage",
+		"shallow, history starts":        "flacher Klon, Verlauf ab",
+		"Repository:":                    "Repository:",
+		"Branch:":                        "Branch:",
+		"Head:":                          "Head:",
+		"LFS:":                           "LFS:",
+		"URL:":                           "URL:",
+		"Worktrees:":                     "Worktrees:",
+		"Version:":                       "Version:",
+		"Branches:":                      "Branches:",
+		"CI/CD:":                         "CI/CD:",
+		"Tests:":                         "Tests:",
+		"Commits:":                       "Commits:",
+		"Stash:":                         "Stash:",
+		"Git:":                           "Git:",
+		"Status:":                        "Status:",
+		"Hotspots":                       "Hotspots",
+		"Releases":                       "Releases",
+		"Commits":                        "Commits",
+		"%.1f commits per week, %s":      "%.1f Commits pro Woche, %s",
+		"%.1f commits per week, %s (%s)": "%.1f Commits pro Woche, %s (%s)",
+		"%.1f/wk":                        "%.1f/Wo",
+		"%5d files %8d code":             "%5d Dateien %8d Code",
+		"%s and %s change together: %d shared commits, %.0f%% confidence": "%s und %s ändern sich gemeinsam: %d gemeinsame Commits, %.0f%% Konfidenz",
+		"%s lines added (%s)":   "%s Zeilen hinzugefügt (%s)",
+		"%s lines deleted (%s)": "%s Zeilen gelöscht (%s)",
+		"%s/%s panes  %s/%s move  enter select  esc back  q quit": "%s/%s Bereiche  %s/%s bewegen  Enter wählen  Esc zurück  q beenden",
+		"(%d behind %s)":                        "(%d hinter %s)",
+		"(%d changes, %s lines, complexity %s)": "(%d Änderungen, %s Zeilen, Komplexität %s)",
+		"(%d files, %d skipped)":                "(%d Dateien, %d übersprungen)",
+		"(%d local)":                            "(%d lokal)",
+		"(%d stale)":                            "(%d veraltet)",
+		"(%d total)":                            "(%d insgesamt)",
+		"(%s comments, %s blank)":               "(%s Kommentare, %s leer)",
+		"(%s test / %s code)":                   "(%s Test / %s Code)",
+		"(%s, esc to go back)":                  "(%s, Esc für zurück)",
+		"(bare)":                                "(bare)",
+		"(deleted)":                             "(gelöscht)",
+		"(enter to filter hot files and activity)": "(Enter filtert Dateien und Aktivität)",
+		"(enter to list commits)":                  "(Enter listet Commits)",
+		"(enter to list files)":                    "(Enter listet Dateien)",
+		"(not initialized)":                        "(nicht initialisiert)",
+		"(this one linked)":                        "(dieser verknüpft)",
+		"(up to date with %s)":                     "(aktuell mit %s)",
+		", %d ahead of and %d behind %s":           ", %d vor und %d hinter %s",
+		", %d ahead, %d behind":                    ", %d voraus, %d zurück",
+		"Activity":                                 "Aktivität",
+		"Authors":                                  "Autoren",
+		"Blank":                                    "Leer",
+		"By %s (esc to clear)":                     "Von %s (Esc zum Aufheben)",
+		"By author:":                               "Nach Autor:",
+		"Code":                                     "Code",
+		"Comment":                                  "Kommentare",
+		"Conventional (scoped)":                    "Conventional (mit Scope)",
+		"Coupled":                                  "Gekoppelt",
+		"Custom":                                   "Eigene",
+		"Files":                                    "Dateien",
+		"Freeform":                                 "Frei",
+		"LOC":                                      "Zeilen",
+		"Language":                                 "Sprache",
+		"Loading":                                  "Lädt",
+		"No changes in the last 90 days":           "Keine Änderungen in den letzten 90 Tagen",
+		"Not a git repository":                     "Kein Git-Repository",
+		"Overview":                                 "Übersicht",
+		"Total":                                    "Gesamt",
+		"author: %s":                               "Autor: %s",
+		"changes":                                  "Änderungen",
+		"clean":                                    "sauber",
+		"complexity":                               "Komplexität",
+		"falling":                                  "fallend",
+		"git gc recommended":                       "git gc empfohlen",
+		"partial":                                  "partiell",
+		"rising":                                   "steigend",
+		"steady":                                   "stabil",
+		"times":                                    "mal",
+		"unknown":                                  "unbekannt",
+		"vs":                                       "vs.",
+		"weekly %s, oldest first":                  "wöchentlich %s, älteste zuerst",
+	},
+	plurals: map[string][2]string{
+		"%d days ago":                     {"vor %d Tag", "vor %d Tagen"},
+		"%d months ago":                   {"vor %d Monat", "vor %d Monaten"},
+		"%d years ago":                    {"vor %d Jahr", "vor %d Jahren"},
+		"past %d weeks":                   {"letzte %d Woche", "letzte %d Wochen"},
+		"%d commits":                      {"%d Commit", "%d Commits"},
+		"past %d months":                  {"letzter %d Monat", "letzte %d Monate"},
+		"%d lines":                        {"%d Zeile", "%d Zeilen"},
+		"%d author days":                  {"%d Autorentag", "%d Autorentage"},
+		"%d added":                        {"%d hinzugefügt", "%d hinzugefügt"},
+		"%d changes":                      {"%d Änderung", "%d Änderungen"},
+		"%d deleted":                      {"%d gelöscht", "%d gelöscht"},
+		"%d entries":                      {"%d Eintrag", "%d Einträge"},
+		"%d modified":                     {"%d geändert", "%d geändert"},
+		"%d objects":                      {"%d Objekt", "%d Objekte"},
+		"%d untracked":                    {"%d unversioniert", "%d unversioniert"},
+		"(%d files)":                      {"(%d Datei)", "(%d Dateien)"},
+		"in %d packs":                     {"in %d Pack", "in %d Packs"},
+		"%d files":                        {"%d Datei", "%d Dateien"},
+		"(%d commits)":                    {"(%d Commit)", "(%d Commits)"},
+		"(shallow, %d commits available)": {"(flacher Klon, %d Commit verfügbar)", "(flacher Klon, %d Commits verfügbar)"},
+		"%d loose objects":                {"%d loses Objekt", "%d lose Objekte"},
+		"%d packs":                        {"%d Pack", "%d Packs"},
+		"%d garbage files":                {"%d Mülldatei", "%d Mülldateien"},
+	},
+	months:   [12]string{"Jan", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"},
+	weekdays: [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+	singular: oneIsSingular,
+}
+
+var spanish = &catalog{
+	messages: map[string]string{
+		"Repository:":                    "Repositorio:",
+		"Branch:":                        "Rama:",
+		"Author:":                        "Autor:",
+		"Created:":                       "Creado:",
+		"Last active:":                   "Última actividad:",
+		"Languages:":                     "Lenguajes:",
+		"Size:":                          "Tamaño:",
+		"Lines:":                         "Líneas:",
+		"Clone:":                         "Clon:",
+		"Authors:":                       "Autores:",
+		"Version:":                       "Versión:",
+		"License:":                       "Licencia:",
+		"Velocity:":                      "Ritmo:",
+		"Deps:":                          "Dependencias:",
+		"Branches:":                      "Ramas:",
+		"Tests:":                         "Pruebas:",
+		"Status:":                        "Estado:",
+		"Packed:":                        "Empaquetados:",
+		"Loose:":                         "Sueltos:",
+		"Maintenance:":                   "Mantenimiento:",
+		"Largest blobs:":                 "Blobs más grandes:",
+		"Languages":                      "Lenguajes",
+		"Lines of Code":                  "Líneas de código",
+		"Top Authors":                    "Autores principales",
+		"Hot Files":                      "Archivos más modificados",
+		"Hotspots":                       "Puntos críticos",
+		"Coupled Files":                  "Archivos acoplados",
+		"Churn":                          "Volumen de cambios",
+		"Storage":                        "Almacenamiento",
+		"Submodules":                     "Submódulos",
+		"Releases":                       "Versiones",
+		"Commit Activity":                "Actividad de commits",
+		"(90 days)":                      "(90 días)",
+		"past year":                      "último año",
+		"Less":                           "Menos",
+		"More":                           "Más",
+		"today":                          "hoy",
+		"Month":                          "Mes",
+		"Busiest day":                    "Día más activo",
+		"Busiest weekday:":               "Día de la semana más activo:",
+		"by month":                       "por mes",
+		"Lines Changed":                  "Líneas modificadas",
+		"Author Activity":                "Actividad de autores",
+		"Lines":                          "Líneas",
+		"Author days":                    "Días-autor",
+		"shallow, history starts":        "clon superficial, historial desde",
+		"Head:":                          "Head:",
+		"LFS:":                           "LFS:",
+		"URL:":                           "URL:",
+		"Worktrees:":                     "Worktrees:",
+		"CI/CD:":                         "CI/CD:",
+		"Commits:":                       "Commits:",
+		"Stash:":                         "Stash:",
+		"Git:":                           "Git:",
+		"Commits":                        "Commits",
+		"%.1f commits per week, %s":      "%.1f commits por semana, %s",
+		"%.1f commits per week, %s (%s)": "%.1f commits por semana, %s (%s)",
+		"%.1f/wk":                        "%.1f/sem",
+		"%5d files %8d code":             "%5d archivos %8d código",
+		"%s and %s change together: %d shared commits, %.0f%% confidence": "%s y %s cambian juntos: %d commits compartidos, %.0f%% de confianza",
+		"%s lines added (%s)":   "%s líneas añadidas (%s)",
+		"%s lines deleted (%s)": "%s líneas eliminadas (%s)",
+		"%s/%s panes  %s/%s move  enter select  esc back  q quit": "%s/%s paneles  %s/%s mover  intro elegir  esc volver  q salir",
+		"(%d behind %s)":                        "(%d por detrás de %s)",
+		"(%d changes, %s lines, complexity %s)": "(%d cambios, %s líneas, complejidad %s)",
+		"(%d files, %d skipped)":                "(%d archivos, %d omitidos)",
+		"(%d local)":                            "(%d locales)",
+		"(%d stale)":                            "(%d inactivas)",
+		"(%d total)":                            "(%d en total)",
+		"(%s comments, %s blank)":               "(%s comentarios, %s en blanco)",
+		"(%s test / %s code)":                   "(%s prueba / %s código)",
+		"(%s, esc to go back)":                  "(%s, esc para volver)",
+		"(bare)":                                "(bare)",
+		"(deleted)":                             "(eliminado)",
+		"(enter to filter hot files and activity)": "(intro para filtrar archivos y actividad)",
+		"(enter to list commits)":                  "(intro para listar commits)",
+		"(enter to list files)":                    "(intro para listar archivos)",
+		"(not initialized)":                        "(sin inicializar)",
+		"(this one linked)":                        "(este vinculado)",
+		"(up to date with %s)":                     "(al día con %s)",
+		", %d ahead of and %d behind %s":           ", %d por delante y %d por detrás de %s",
+		", %d ahead, %d behind":                    ", %d por delante, %d por detrás",
+		"Activity":                                 "Actividad",
+		"Authors":                                  "Autores",
+		"Blank":                                    "Vacías",
+		"By %s (esc to clear)":                     "De %s (esc para quitar)",
+		"By author:":                               "Por autor:",
+		"Code":                                     "Código",
+		"Comment":                                  "Comentarios",
+		"Conventional (scoped)":                    "Conventional (con ámbito)",
+		"Coupled":                                  "Acoplados",
+		"Custom":                                   "Personalizada",
+		"Files":                                    "Archivos",
+		"Freeform":                                 "Libre",
+		"LOC":                                      "líneas",
+		"Language":                                 "Lenguaje",
+		"Loading":                                  "Cargando",
+		"No changes in the last 90 days":           "Sin cambios en los últimos 90 días",
+		"Not a git repository":                     "No es un repositorio git",
+		"Overview":                                 "Resumen",
+		"Total":                                    "Total",
+		"author: %s":                               "autor: %s",
+		"changes":                                  "cambios",
+		"clean":                                    "limpio",
+		"complexity":                               "complejidad",
+		"falling":                                  "a la baja",
+		"git gc recommended":                       "se recomienda git gc",
+		"partial":                                  "parcial",
+		"rising":                                   "al alza",
+		"steady":                                   "estable",
+		"times":                                    "por",
+		"unknown":                                  "desconocido",
+		"vs":                                       "vs",
+		"weekly %s, oldest first":                  "semanal %s, de la más antigua a la más reciente",
+	},
+	plurals: map[string][2]string{
+		"%d days ago":                     {"hace %d día", "hace %d días"},
+		"%d months ago":                   {"hace %d mes", "hace %d meses"},
+		"%d years ago":                    {"hace %d año", "hace %d años"},
+		"past %d weeks":                   {"última %d semana", "últimas %d semanas"},
+		"%d commits":                      {"%d commit", "%d commits"},
+		"past %d months":                  {"último %d mes", "últimos %d meses"},
+		"%d lines":                        {"%d línea", "%d líneas"},
+		"%d author days":                  {"%d día-autor", "%d días-autor"},
+		"%d added":                        {"%d añadido", "%d añadidos"},
+		"%d changes":                      {"%d cambio", "%d cambios"},
+		"%d deleted":                      {"%d eliminado", "%d eliminados"},
+		"%d entries":                      {"%d entrada", "%d entradas"},
+		"%d modified":                     {"%d modificado", "%d modificados"},
+		"%d objects":                      {"%d objeto", "%d objetos"},
+		"%d untracked":                    {"%d sin seguimiento", "%d sin seguimiento"},
+		"(%d files)":                      {"(%d archivo)", "(%d archivos)"},
+		"in %d packs":                     {"en %d pack", "en %d packs"},
+		"%d files":                        {"%d archivo", "%d archivos"},
+		"(%d commits)":                    {"(%d commit)", "(%d commits)"},
+		"(shallow, %d commits available)": {"(clon superficial, %d commit disponible)", "(clon superficial, %d commits disponibles)"},
+		"%d loose objects":                {"%d objeto suelto", "%d objetos sueltos"},
+		"%d packs":                        {"%d pack", "%d packs"},
+		"%d garbage files":                {"%d archivo basura", "%d archivos basura"},
+	},
+	months:   [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sep", "oct", "nov", "dic"},
+	weekdays: [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+	singular: oneIsSingular,
+}
+
+var japanese = &catalog{
+	messages: map[string]string{
+		"Repository:":                    "リポジトリ:",
+		"Branch:":                        "ブランチ:",
+		"Author:":                        "作成者:",
+		"Created:":                       "作成日:",
+		"Last active:":                   "最終更新:",
+		"Languages:":                     "言語:",
+		"Size:":                          "サイズ:",
+		"Lines:":                         "行数:",
+		"Clone:":                         "クローン:",
+		"Worktrees:":                     "ワークツリー:",
+		"Authors:":                       "作成者数:",
+		"Version:":                       "バージョン:",
+		"License:":                       "ライセンス:",
+		"Velocity:":                      "ペース:",
+		"Deps:":                          "依存関係:",
+		"Branches:":                      "ブランチ数:",
+		"Tests:":                         "テスト:",
+		"Commits:":                       "コミット:",
+		"Stash:":                         "スタッシュ:",
+		"Status:":                        "状態:",
+		"Packed:":                        "パック済み:",
+		"Loose:":                         "ルーズ:",
+		"Maintenance:":                   "メンテナンス:",
+		"Largest blobs:":                 "最大のblob:",
+		"Languages":                      "言語",
+		"Lines of Code":                  "コード行数",
+		"Top Authors":                    "主な作成者",
+		"Hot Files":                      "よく変更されるファイル",
+		"Hotspots":                       "ホットスポット",
+		"Coupled Files":                  "同時に変更されるファイル",
+		"Churn":                          "変更量",
+		"Storage":                        "ストレージ",
+		"Submodules":                     "サブモジュール",
+		"Releases":                       "リリース",
+		"Commit Activity":                "コミットアクティビティ",
+		"(90 days)":                      "(90日間)",
+		"past year":                      "過去1年",
+		"Less":                           "少",
+		"More":                           "多",
+		"today":                          "今日",
+		"Month":                          "月",
+		"Busiest day":                    "最も活発な日",
+		"Busiest weekday:":               "最も活発な曜日:",
+		"by month":                       "月別",
+		"Lines Changed":                  "変更行数",
+		"Author Activity":                "作成者のアクティビティ",
+		"Commits":                        "コミット",
+		"Lines":                          "行数",
+		"Author days":                    "作成者日数",
+		"shallow, history starts":        "シャロークローン、履歴の開始",
+		"Head:":                          "HEAD:",
+		"LFS:":                           "LFS:",
+		"URL:":                           "URL:",
+		"CI/CD:":                         "CI/CD:",
+		"Git:":                           "Git:",
+		"%.1f commits per week, %s":      "週あたり%.1fコミット、%s",
+		"%.1f commits per week, %s (%s)": "週あたり%.1fコミット、%s(%s)",
+		"%.1f/wk":                        "%.1f/週",
+		"%5d files %8d code":             "%5dファイル %8d行",
+		"%s and %s change together: %d shared commits, %.0f%% confidence": "%sと%sは同時に変更されます:共通コミット%d件、信頼度%.0f%%",
+		"%s lines added (%s)":   "%s行追加(%s)",
+		"%s lines deleted (%s)": "%s行削除(%s)",
+		"%s/%s panes  %s/%s move  enter select  esc back  q quit": "%s/%s ペイン  %s/%s 移動  enter 選択  esc 戻る  q 終了",
+		"(%d behind %s)":                        "(%[2]sより%[1]d遅れ)",
+		"(%d changes, %s lines, complexity %s)": "(変更%d回、%s行、複雑度%s)",
+		"(%d files, %d skipped)":                "(%dファイル、%d件スキップ)",
+		"(%d local)":                            "(ローカル%d)",
+		"(%d stale)":                            "(%d件が古い)",
+		"(%d total)":                            "(合計%d)",
+		"(%s comments, %s blank)":               "(コメント%s、空行%s)",
+		"(%s test / %s code)":                   "(テスト%s / コード%s)",
+		"(%s, esc to go back)":                  "(%s、escで戻る)",
+		"(bare)":                                "(ベア)",
+		"(deleted)":                             "(削除済み)",
+		"(enter to filter hot files and activity)": "(enterでファイルとアクティビティを絞り込み)",
+		"(enter to list commits)":                  "(enterでコミット一覧)",
+		"(enter to list files)":                    "(enterでファイル一覧)",
+		"(not initialized)":                        "(未初期化)",
+		"(this one linked)":                        "(これはリンク)",
+		"(up to date with %s)":                     "(%sと同期済み)",
+		", %d ahead of and %d behind %s":           "、%[3]sより%[1]d進んで%[2]d遅れ",
+		", %d ahead, %d behind":                    "、%d進み、%d遅れ",
+		"Activity":                                 "アクティビティ",
+		"Authors":                                  "作成者",
+		"Blank":                                    "空行",
+		"By %s (esc to clear)":                     "%sによる変更(escで解除)",
+		"By author:":                               "作成者別:",
+		"Code":                                     "コード",
+		"Comment":                                  "コメント",
+		"Conventional (scoped)":                    "Conventional(スコープ付き)",
+		"Coupled":                                  "同時変更",
+		"Custom":                                   "独自",
+		"Files":                                    "ファイル",
+		"Freeform":                                 "自由形式",
+		"LOC":                                      "行",
+		"Language":                                 "言語",
+		"Loading":                                  "読み込み中",
+		"No changes in the last 90 days":           "過去90日間の変更はありません",
+		"Not a git repository":                     "gitリポジトリではありません",
+		"Overview":                                 "概要",
+		"Total":                                    "合計",
+		"author: %s":                               "作成者: %s",
+		"changes":                                  "変更回数",
+		"clean":                                    "クリーン",
+		"complexity":                               "複雑度",
+		"falling":                                  "減少",
+		"git gc recommended":                       "git gcを推奨",
+		"partial":                                  "部分",
+		"rising":                                   "増加",
+		"steady":                                   "横ばい",
+		"times":                                    "×",
+		"unknown":                                  "不明",
+		"vs":                                       "対",
+		"weekly %s, oldest first":                  "週ごと %s(古い順)",
+	},
+	plurals: map[string][2]string{
+		"%d days ago":                     {"%d日前", "%d日前"},
+		"%d months ago":                   {"%dか月前", "%dか月前"},
+		"%d years ago":                    {"%d年前", "%d年前"},
+		"past %d weeks":                   {"過去%d週間", "過去%d週間"},
+		"%d commits":                      {"%dコミット", "%dコミット"},
+		"past %d months":                  {"過去%dか月", "過去%dか月"},
+		"%d lines":                        {"%d行", "%d行"},
+		"%d author days":                  {"%d作成者日", "%d作成者日"},
+		"%d added":                        {"追加%d", "追加%d"},
+		"%d changes":                      {"%d回変更", "%d回変更"},
+		"%d deleted":                      {"削除%d", "削除%d"},
+		"%d entries":                      {"%d件", "%d件"},
+		"%d modified":                     {"変更%d", "変更%d"},
+		"%d objects":                      {"%dオブジェクト", "%dオブジェクト"},
+		"%d untracked":                    {"未追跡%d", "未追跡%d"},
+		"(%d files)":                      {"(%dファイル)", "(%dファイル)"},
+		"in %d packs":                     {"%dパック内", "%dパック内"},
+		"%d files":                        {"%dファイル", "%dファイル"},
+		"(%d commits)":                    {"(%dコミット)", "(%dコミット)"},
+		"(shallow, %d commits available)": {"(シャロークローン、%dコミット取得済み)", "(シャロークローン、%dコミット取得済み)"},
+		"%d loose objects":                {"%d個の未パックオブジェクト", "%d個の未パックオブジェクト"},
+		"%d packs":                        {"%dパック", "%dパック"},
+		"%d garbage files":                {"%d個の不要ファイル", "%d個の不要ファイル"},
+	},
+	months:   [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+	weekdays: [7]string{"日", "月", "火", "水", "木", "金", "土"},
+	singular: func(int) bool { return false },
+}
@@ -0,0 +1,124 @@
+// Package i18n translates labels, section titles and relative times. Messages
+// are looked up by their English text, which is also the fallback when a
+// catalog has no translation.
+package i18n
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// catalog holds the translations of one language.
+type catalog struct {
+	messages map[string]string
+	plurals  map[string][2]string // singular and plural, keyed by the English plural
+	months   [12]string           // abbreviated, January first
+	weekdays [7]string            // abbreviated, Sunday first
+	singular func(n int) bool     // whether n takes the singular form
+}
+
+func oneIsSingular(n int) bool { return n == 1 }
+
+var english = &catalog{
+	months:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+	weekdays: [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	singular: oneIsSingular,
+}
+
+var catalogs = map[string]*catalog{
+	"en": english,
+	"fr": french,
+	"de": german,
+	"es": spanish,
+	"ja": japanese,
+}
+
+var current = english
+
+// Languages lists the language codes with a catalog.
+func Languages() []string {
+	var codes []string
+	for code := range catalogs {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// Detect returns the locale from LC_ALL, LC_MESSAGES or LANG, in that
+// order of precedence, like gettext.
+func Detect() string {
+	for _, env := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if v := os.Getenv(env); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// SetLocale switches to the catalog for a locale such as "fr_FR.UTF-8",
+// "de-AT" or "ja". It reports false and keeps English for locales without
+// a catalog, including "C" and "POSIX".
+func SetLocale(locale string) bool {
+	lang := strings.ToLower(locale)
+	if i := strings.IndexAny(lang, "_-.@"); i >= 0 {
+		lang = lang[:i]
+	}
+	c, ok := catalogs[lang]
+	if !ok {
+		current = english
+		return false
+	}
+	current = c
+	return true
+}
+
+// T translates msg.
+func T(msg string) string {
+	if s, ok := current.messages[msg]; ok {
+		return s
+	}
+	return msg
+}
+
+// N formats n with the singular or plural form of a message, such as
+// N(3, "%d day ago", "%d days ago"). Languages differ in which counts are
+// singular: French uses it for 0 as well, Japanese never does.
+func N(n int, singular, plural string) string {
+	forms := [2]string{singular, plural}
+	if tr, ok := current.plurals[plural]; ok {
+		forms = tr
+	}
+	if current.singular(n) {
+		return fmt.Sprintf(forms[0], n)
+	}
+	return fmt.Sprintf(forms[1], n)
+}
+
+// Month returns the abbreviated name of m.
+func Month(m time.Month) string {
+	return current.months[m-1]
+}
+
+// Weekday returns the abbreviated name of d.
+func Weekday(d time.Weekday) string {
+	return current.weekdays[d]
+}
+
+// TimeAgo describes how long ago t was, e.g. "3 days ago".
+func TimeAgo(t time.Time) string {
+	days := int(time.Since(t).Hours() / 24)
+	switch {
+	case days < 1:
+		return T("today")
+	case days < 30:
+		return N(days, "%d day ago", "%d days ago")
+	case days < 365:
+		return N(days/30, "%d month ago", "%d months ago")
+	default:
+		return N(days/365, "%d year ago", "%d years ago")
+	}
+}
@@ -0,0 +1,161 @@
+package i18n
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestN(t *testing.T) {
+	defer SetLocale("en")
+	tests := []struct {
+		locale string
+		n      int
+		want   string
+	}{
+		{"en", 0, "0 lines"},
+		{"en", 1, "1 line"},
+		{"en", 2, "2 lines"},
+		{"fr", 0, "0 ligne"},
+		{"fr", 1, "1 ligne"},
+		{"fr", 2, "2 lignes"},
+		{"de", 0, "0 Zeilen"},
+		{"de", 1, "1 Zeile"},
+		{"es", 1, "1 línea"},
+		{"es", 5, "5 líneas"},
+		{"ja", 1, "1行"},
+		{"ja", 3, "3行"},
+	}
+	for _, tt := range tests {
+		SetLocale(tt.locale)
+		if got := N(tt.n, "%d line", "%d lines"); got != tt.want {
+			t.Errorf("%s: N(%d) = %q, want %q", tt.locale, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestNWithoutTranslation(t *testing.T) {
+	defer SetLocale("en")
+	SetLocale("fr")
+	// French treats 0 as singular even for messages it has no plural for
+	if got := N(0, "%d widget", "%d widgets"); got != "0 widget" {
+		t.Errorf("N(0) = %q, want %q", got, "0 widget")
+	}
+}
+
+func TestSetLocale(t *testing.T) {
+	defer SetLocale("en")
+	tests := []struct {
+		locale string
+		ok     bool
+		want   string
+	}{
+		{"fr_FR.UTF-8", true, "Dépôt :"},
+		{"de-AT", true, "Repository:"},
+		{"es_MX@euro", true, "Repositorio:"},
+		{"JA", true, "リポジトリ:"},
+		{"C", false, "Repository:"},
+		{"POSIX", false, "Repository:"},
+		{"", false, "Repository:"},
+	}
+	for _, tt := range tests {
+		SetLocale("fr")
+		if ok := SetLocale(tt.locale); ok != tt.ok {
+			t.Errorf("SetLocale(%q) = %v, want %v", tt.locale, ok, tt.ok)
+		}
+		if got := T("Repository:"); got != tt.want {
+			t.Errorf("after SetLocale(%q), T(\"Repository:\") = %q, want %q", tt.locale, got, tt.want)
+		}
+	}
+}
+
+func TestTimeAgo(t *testing.T) {
+	defer SetLocale("en")
+	now := time.Now()
+	tests := []struct {
+		locale string
+		ago    time.Duration
+		want   string
+	}{
+		{"en", time.Hour, "today"},
+		{"en", 24 * time.Hour, "1 day ago"},
+		{"en", 45 * 24 * time.Hour, "1 month ago"},
+		{"en", 800 * 24 * time.Hour, "2 years ago"},
+		{"fr", 3 * 24 * time.Hour, "il y a 3 jours"},
+		{"de", 400 * 24 * time.Hour, "vor 1 Jahr"},
+		{"ja", 10 * 24 * time.Hour, "10日前"},
+	}
+	for _, tt := range tests {
+		SetLocale(tt.locale)
+		if got := TimeAgo(now.Add(-tt.ago)); got != tt.want {
+			t.Errorf("%s: TimeAgo(-%v) = %q, want %q", tt.locale, tt.ago, got, tt.want)
+		}
+	}
+}
+
+// verbRe matches a fmt verb, with an optional argument index and width.
+var verbRe = regexp.MustCompile(`%(\[\d+\])?[-+# 0]*\d*(\.\d+)?[a-z]`)
+
+// verbs returns the fmt verbs of a message, sorted so that translations
+// may reorder their arguments.
+func verbs(msg string) []string {
+	var vs []string
+	for _, v := range verbRe.FindAllString(strings.ReplaceAll(msg, "%%", ""), -1) {
+		vs = append(vs, regexp.MustCompile(`\[\d+\]`).ReplaceAllString(v, ""))
+	}
+	sort.Strings(vs)
+	return vs
+}
+
+func sameVerbs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestCatalogs(t *testing.T) {
+	// Every catalog translates the same messages, so a language can't
+	// fall back to English for some of them unnoticed
+	want := french
+	for code, c := range catalogs {
+		if c == english {
+			continue
+		}
+		for msg := range want.messages {
+			if _, ok := c.messages[msg]; !ok {
+				t.Errorf("%s: no translation for %q", code, msg)
+			}
+		}
+		for msg := range c.messages {
+			if _, ok := want.messages[msg]; !ok {
+				t.Errorf("%s: %q is missing from the French catalog", code, msg)
+			}
+		}
+		for msg := range want.plurals {
+			if _, ok := c.plurals[msg]; !ok {
+				t.Errorf("%s: no plural for %q", code, msg)
+			}
+		}
+
+		for msg, tr := range c.messages {
+			if !sameVerbs(verbs(msg), verbs(tr)) {
+				t.Errorf("%s: %q translates %q with different verbs", code, tr, msg)
+			}
+		}
+		for msg, forms := range c.plurals {
+			for _, tr := range forms {
+				if !sameVerbs(verbs(msg), verbs(tr)) {
+					t.Errorf("%s: %q translates %q with different verbs", code, tr, msg)
+				}
+			}
+		}
+	}
+}
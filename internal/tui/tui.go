@@ -0,0 +1,485 @@
+// Package tui is the interactive mode of gfetch (--tui). It shows the same
+// sections as the one-shot display as panes and lets the user drill into
+// the data behind them.
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/fayssal-elmofatiche/gfetch/internal/git"
+	"github.com/fayssal-elmofatiche/gfetch/internal/i18n"
+	"github.com/fayssal-elmofatiche/gfetch/internal/ui"
+)
+
+var (
+	tabStyle       = lipgloss.NewStyle().Foreground(lipgloss.Color("#8B949E")).Padding(0, 1)
+	activeTabStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#F0883E")).Padding(0, 1)
+	titleStyle     = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#F0883E"))
+	valueStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("#E6EDF3"))
+	dimStyle       = lipgloss.NewStyle().Foreground(lipgloss.Color("#8B949E"))
+	cursorStyle    = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#6CB6FF"))
+)
+
+// Data is everything gfetch collected, as passed to the one-shot renderer.
+type Data struct {
+	Overview     string // rendered logo and info panel
+	CodeStats    git.CodeStats
+	Contributors git.ContributorStats
+	HotFiles     []git.HotFile
+	Hotspots     []git.Hotspot
+	Coupled      []git.CoupledFiles
+	Churn        git.Churn
+	Releases     []git.Release
+	Submodules   []git.Submodule
+	Storage      *git.Storage      // nil unless --storage
+	Activity     map[string]int    // heatmap value per day
+	Heatmap      ui.HeatmapOptions // range, metric and scale of the heatmap
+}
+
+type pane int
+
+const (
+	paneOverview pane = iota
+	paneLanguages
+	paneContributors
+	paneHotFiles
+	paneHotspots
+	paneCoupled
+	paneChurn
+	paneReleases
+	paneSubmodules
+	paneStorage
+	paneActivity
+)
+
+// paneTitles are the English tab titles, translated when rendered.
+var paneTitles = map[pane]string{
+	paneOverview:     "Overview",
+	paneLanguages:    "Languages",
+	paneContributors: "Authors",
+	paneHotFiles:     "Hot Files",
+	paneHotspots:     "Hotspots",
+	paneCoupled:      "Coupled",
+	paneChurn:        "Churn",
+	paneReleases:     "Releases",
+	paneSubmodules:   "Submodules",
+	paneStorage:      "Storage",
+	paneActivity:     "Activity",
+}
+
+// authorLoadedMsg carries hot files and daily activity filtered to an author.
+type authorLoadedMsg struct {
+	author   string
+	hotFiles []git.HotFile
+	activity map[string]int
+}
+
+// releaseLoadedMsg carries the commit list of a release.
+type releaseLoadedMsg struct {
+	tag     string
+	commits []git.Commit
+}
+
+type model struct {
+	data   Data
+	panes  []pane
+	active int
+	cursor map[pane]int
+	scroll int
+	width  int
+	height int
+
+	// Drill-down state
+	language       string // files of this language are listed
+	author         string // hot files and activity are filtered to this author
+	authorHotFiles []git.HotFile
+	authorActivity map[string]int
+	authorLoading  bool
+	release        string // commits of this release are listed
+	releaseCommits []git.Commit
+	releaseLoading bool
+}
+
+// Run shows data until the user quits.
+func Run(data Data) error {
+	m := model{data: data, cursor: make(map[pane]int)}
+	m.panes = []pane{paneOverview}
+	if len(data.CodeStats.Lines) > 0 {
+		m.panes = append(m.panes, paneLanguages)
+	}
+	if len(data.Contributors.Top) > 0 {
+		m.panes = append(m.panes, paneContributors)
+	}
+	m.panes = append(m.panes, paneHotFiles)
+	if len(data.Hotspots) > 0 {
+		m.panes = append(m.panes, paneHotspots)
+	}
+	if len(data.Coupled) > 0 {
+		m.panes = append(m.panes, paneCoupled)
+	}
+	if len(data.Churn.Files) > 0 {
+		m.panes = append(m.panes, paneChurn)
+	}
+	if len(data.Releases) > 0 {
+		m.panes = append(m.panes, paneReleases)
+	}
+	if len(data.Submodules) > 0 {
+		m.panes = append(m.panes, paneSubmodules)
+	}
+	if data.Storage != nil {
+		m.panes = append(m.panes, paneStorage)
+	}
+	m.panes = append(m.panes, paneActivity)
+
+	_, err := tea.NewProgram(m, tea.WithAltScreen()).Run()
+	return err
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+
+	case authorLoadedMsg:
+		if msg.author == m.author {
+			m.authorHotFiles, m.authorActivity = msg.hotFiles, msg.activity
+			m.authorLoading = false
+		}
+
+	case releaseLoadedMsg:
+		if msg.tag == m.release {
+			m.releaseCommits = msg.commits
+			m.releaseLoading = false
+		}
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "ctrl+c":
+			return m, tea.Quit
+		case "tab", "right", "l":
+			m.active = (m.active + 1) % len(m.panes)
+			m.scroll = 0
+		case "shift+tab", "left", "h":
+			m.active = (m.active + len(m.panes) - 1) % len(m.panes)
+			m.scroll = 0
+		case "up", "k":
+			m.move(-1)
+		case "down", "j":
+			m.move(1)
+		case "pgup":
+			m.move(-m.bodyHeight())
+		case "pgdown":
+			m.move(m.bodyHeight())
+		case "enter":
+			return m, m.selectItem()
+		case "esc", "backspace":
+			m.back()
+		}
+	}
+	return m, nil
+}
+
+// items is the number of selectable rows in the current pane, or 0 if it
+// only scrolls.
+func (m model) items() int {
+	switch m.panes[m.active] {
+	case paneLanguages:
+		if m.language != "" {
+			return len(m.languageFiles())
+		}
+		return len(m.data.CodeStats.Lines)
+	case paneContributors:
+		return len(m.data.Contributors.Top)
+	case paneReleases:
+		if m.release != "" {
+			return len(m.releaseCommits)
+		}
+		return len(m.data.Releases)
+	}
+	return 0
+}
+
+func (m *model) move(delta int) {
+	p := m.panes[m.active]
+	n := m.items()
+	if n == 0 {
+		m.scroll = max(0, m.scroll+delta)
+		return
+	}
+	m.cursor[p] = min(max(0, m.cursor[p]+delta), n-1)
+}
+
+func (m *model) selectItem() tea.Cmd {
+	p := m.panes[m.active]
+	i := m.cursor[p]
+	switch p {
+	case paneLanguages:
+		if m.language == "" && i < len(m.data.CodeStats.Lines) {
+			m.language = m.data.CodeStats.Lines[i].Name
+			m.cursor[p] = 0
+		}
+	case paneContributors:
+		if i >= len(m.data.Contributors.Top) {
+			return nil
+		}
+		name := m.data.Contributors.Top[i].Name
+		if m.author == name {
+			// Toggle the filter off; a load still running is ignored
+			m.author, m.authorHotFiles, m.authorActivity = "", nil, nil
+			m.authorLoading = false
+			return nil
+		}
+		m.author, m.authorHotFiles, m.authorActivity = name, nil, nil
+		m.authorLoading = true
+		return func() tea.Msg {
+			activity, _ := git.GetDailyActivity(m.data.Heatmap.Metric, name, m.data.Heatmap.Since())
+			return authorLoadedMsg{author: name, hotFiles: git.GetAuthorHotFiles(name, 20), activity: activity}
+		}
+	case paneReleases:
+		if m.release != "" || i >= len(m.data.Releases) {
+			return nil
+		}
+		tag := m.data.Releases[i].Tag
+		m.release, m.releaseCommits = tag, nil
+		m.cursor[p] = 0
+		m.releaseLoading = true
+		return func() tea.Msg {
+			return releaseLoadedMsg{tag: tag, commits: git.GetReleaseCommits(tag)}
+		}
+	}
+	return nil
+}
+
+// back leaves a drill-down, restoring the cursor to the item drilled into.
+func (m *model) back() {
+	p := m.panes[m.active]
+	switch {
+	case p == paneLanguages && m.language != "":
+		for i, l := range m.data.CodeStats.Lines {
+			if l.Name == m.language {
+				m.cursor[p] = i
+			}
+		}
+		m.language = ""
+	case p == paneReleases && m.release != "":
+		for i, r := range m.data.Releases {
+			if r.Tag == m.release {
+				m.cursor[p] = i
+			}
+		}
+		m.release, m.releaseCommits = "", nil
+		m.releaseLoading = false
+	case p == paneContributors || p == paneHotFiles || p == paneActivity:
+		m.author, m.authorHotFiles, m.authorActivity = "", nil, nil
+		m.authorLoading = false
+	}
+}
+
+// languageFiles lists the files of the drilled-into language, largest first.
+func (m model) languageFiles() []string {
+	var files []string
+	for path, fm := range m.data.CodeStats.Files {
+		if fm.Language == m.language {
+			files = append(files, path)
+		}
+	}
+	sort.Slice(files, func(i, j int) bool {
+		a, b := m.data.CodeStats.Files[files[i]], m.data.CodeStats.Files[files[j]]
+		if a.LOC != b.LOC {
+			return a.LOC > b.LOC
+		}
+		return files[i] < files[j]
+	})
+	return files
+}
+
+// bodyHeight is the number of lines available below the tabs and above
+// the key help.
+func (m model) bodyHeight() int {
+	return max(1, m.height-4)
+}
+
+func (m model) View() string {
+	if m.width == 0 {
+		return ""
+	}
+
+	var tabs []string
+	for i, p := range m.panes {
+		if i == m.active {
+			tabs = append(tabs, activeTabStyle.Render(i18n.T(paneTitles[p])))
+		} else {
+			tabs = append(tabs, tabStyle.Render(i18n.T(paneTitles[p])))
+		}
+	}
+	header := lipgloss.NewStyle().MaxWidth(m.width).Render(strings.Join(tabs, ""))
+
+	lines, selected := m.body()
+	h := m.bodyHeight()
+	offset := m.scroll
+	if selected >= 0 {
+		offset = max(0, selected-h+1)
+	}
+	offset = min(offset, max(0, len(lines)-h))
+	lines = lines[offset:]
+	if len(lines) > h {
+		lines = lines[:h]
+	}
+	for len(lines) < h {
+		lines = append(lines, "")
+	}
+	body := lipgloss.NewStyle().MaxWidth(m.width).Render(strings.Join(lines, "\n"))
+
+	g := ui.CurrentGlyphs()
+	help := fmt.Sprintf(i18n.T("%s/%s panes  %s/%s move  enter select  esc back  q quit"), g.Left, g.Right, g.Up, g.Down)
+	if m.author != "" {
+		help = fmt.Sprintf(i18n.T("author: %s"), m.author) + "  " + help
+	}
+	return header + "\n\n" + body + "\n" + dimStyle.Render(help)
+}
+
+// body renders the active pane as lines, with the index of the line under
+// the cursor, or -1 for panes that only scroll.
+func (m model) body() ([]string, int) {
+	p := m.panes[m.active]
+	switch p {
+	case paneOverview:
+		return split(m.data.Overview), -1
+
+	case paneLanguages:
+		if m.language != "" {
+			files := m.languageFiles()
+			lines := []string{titleStyle.Render(m.language) + dimStyle.Render(" "+i18n.N(len(files), "(%d file)", "(%d files)"))}
+			var items []string
+			for _, f := range files {
+				items = append(items, fmt.Sprintf("%s %s", dimStyle.Render(fmt.Sprintf("%7d", m.data.CodeStats.Files[f].LOC)), valueStyle.Render(f)))
+			}
+			return m.list(lines, items)
+		}
+		lines := split(ui.RenderLanguageBar(m.data.CodeStats.Languages, min(50, m.width)))
+		lines = append(lines, "", titleStyle.Render(i18n.T("Languages"))+dimStyle.Render(" "+i18n.T("(enter to list files)")))
+		// Pad names before styling them, since the escape codes would
+		// count towards the width
+		nameWidth := 0
+		for _, l := range m.data.CodeStats.Lines {
+			nameWidth = max(nameWidth, lipgloss.Width(l.Name))
+		}
+		var items []string
+		for _, l := range m.data.CodeStats.Lines {
+			name := l.Name + strings.Repeat(" ", nameWidth-lipgloss.Width(l.Name))
+			items = append(items, fmt.Sprintf("%s %s", valueStyle.Render(name), dimStyle.Render(fmt.Sprintf(i18n.T("%5d files %8d code"), l.Files, l.Code))))
+		}
+		return m.list(lines, items)
+
+	case paneContributors:
+		lines := []string{titleStyle.Render(i18n.T("Authors")) + dimStyle.Render(" "+i18n.T("(enter to filter hot files and activity)"))}
+		var items []string
+		for _, c := range m.data.Contributors.Top {
+			mark := "  "
+			if c.Name == m.author {
+				mark = cursorStyle.Render(ui.CurrentGlyphs().Dot + " ")
+			}
+			items = append(items, fmt.Sprintf("%s%s %s", mark, valueStyle.Render(c.Name), dimStyle.Render(fmt.Sprintf("(%d)", c.Commits))))
+		}
+		return m.list(lines, items)
+
+	case paneHotFiles:
+		if m.author != "" {
+			lines := []string{dimStyle.Render(fmt.Sprintf(i18n.T("By %s (esc to clear)"), m.author))}
+			switch {
+			case m.authorLoading:
+				lines = append(lines, dimStyle.Render(i18n.T("Loading")+ui.CurrentGlyphs().Ellipsis))
+			case len(m.authorHotFiles) == 0:
+				lines = append(lines, dimStyle.Render(i18n.T("No changes in the last 90 days")))
+			default:
+				lines = append(lines, split(ui.RenderHotFiles(m.authorHotFiles))...)
+			}
+			return lines, -1
+		}
+		if len(m.data.HotFiles) == 0 {
+			return []string{dimStyle.Render(i18n.T("No changes in the last 90 days"))}, -1
+		}
+		return split(ui.RenderHotFiles(m.data.HotFiles)), -1
+
+	case paneHotspots:
+		return split(ui.RenderHotspots(m.data.Hotspots)), -1
+
+	case paneCoupled:
+		return split(ui.RenderCoupledFiles(m.data.Coupled)), -1
+
+	case paneChurn:
+		return split(ui.RenderChurn(m.data.Churn)), -1
+
+	case paneReleases:
+		if m.release != "" {
+			lines := []string{titleStyle.Render(m.release) + dimStyle.Render(" "+fmt.Sprintf(i18n.T("(%s, esc to go back)"), i18n.N(len(m.releaseCommits), "%d commit", "%d commits")))}
+			if m.releaseLoading {
+				return append(lines, dimStyle.Render(i18n.T("Loading")+ui.CurrentGlyphs().Ellipsis)), -1
+			}
+			var items []string
+			for _, c := range m.releaseCommits {
+				items = append(items, fmt.Sprintf("%s %s %s", dimStyle.Render(c.Hash), valueStyle.Render(c.Subject), dimStyle.Render(c.Author)))
+			}
+			return m.list(lines, items)
+		}
+		lines := []string{titleStyle.Render(i18n.T("Releases")) + dimStyle.Render(" "+i18n.T("(enter to list commits)"))}
+
+		var items []string
+		for _, r := range m.data.Releases {
+			items = append(items, fmt.Sprintf("%s %s", valueStyle.Render(r.Tag), dimStyle.Render(r.Age)))
+		}
+		return m.list(lines, items)
+
+	case paneSubmodules:
+		return split(ui.RenderSubmodules(m.data.Submodules)), -1
+
+	case paneStorage:
+		return split(ui.RenderStorage(*m.data.Storage)), -1
+
+	case paneActivity:
+		activity := m.data.Activity
+		var lines []string
+		if m.author != "" {
+			lines = append(lines, dimStyle.Render(fmt.Sprintf(i18n.T("By %s (esc to clear)"), m.author)))
+			if m.authorLoading {
+				return append(lines, dimStyle.Render(i18n.T("Loading")+ui.CurrentGlyphs().Ellipsis)), -1
+			}
+			activity = m.authorActivity
+		}
+		opts := m.data.Heatmap
+		opts.Width = m.width
+		return append(lines, split(ui.RenderHeatmap(activity, opts))...), -1
+	}
+	return nil, -1
+}
+
+// list appends items below header lines, marking the one under the cursor.
+func (m model) list(header, items []string) ([]string, int) {
+	cursor := m.cursor[m.panes[m.active]]
+	lines := header
+	for i, item := range items {
+		if i == cursor {
+			lines = append(lines, cursorStyle.Render(ui.CurrentGlyphs().Cursor+" ")+item)
+		} else {
+			lines = append(lines, "  "+item)
+		}
+	}
+	if len(items) == 0 {
+		return lines, -1
+	}
+	return lines, len(header) + cursor
+}
+
+// split turns a rendered section into lines, dropping the leading blank
+// line sections start with.
+func split(s string) []string {
+	return strings.Split(strings.TrimPrefix(s, "\n"), "\n")
+}
@@ -0,0 +1,138 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/fayssal-elmofatiche/gfetch/internal/git"
+	"github.com/fayssal-elmofatiche/gfetch/internal/i18n"
+)
+
+var accessible bool
+
+// SetAccessible switches renderers to text that reads well in a screen
+// reader: bars become percentages, sparklines weekly numbers and the
+// heatmap a table of monthly commit counts.
+func SetAccessible(on bool) {
+	accessible = on
+}
+
+// Accessible reports whether textual output is in use.
+func Accessible() bool {
+	return accessible
+}
+
+// weeklyNumbers spells out the counts behind a sparkline.
+func weeklyNumbers(counts []int) string {
+	nums := make([]string, len(counts))
+	for i, c := range counts {
+		nums[i] = fmt.Sprintf("%d", c)
+	}
+	return fmt.Sprintf(i18n.T("weekly %s, oldest first"), strings.Join(nums, ", "))
+}
+
+// trendWord describes a velocity trend in words.
+func trendWord(trend string) string {
+	switch trend {
+	case git.TrendUp:
+		return i18n.T("rising")
+	case git.TrendDown:
+		return i18n.T("falling")
+	}
+	return i18n.T("steady")
+}
+
+// metricColumn is the table header for monthly totals of a metric.
+func metricColumn(metric string) string {
+	switch metric {
+	case git.MetricLines:
+		return i18n.T("Lines")
+	case git.MetricAuthors:
+		return i18n.T("Author days")
+	}
+	return i18n.T("Commits")
+}
+
+// metricCount spells out n in the unit of a metric, e.g. "3 commits".
+func metricCount(metric string, n int) string {
+	switch metric {
+	case git.MetricLines:
+		return i18n.N(n, "%d line", "%d lines")
+	case git.MetricAuthors:
+		return i18n.N(n, "%d author day", "%d author days")
+	}
+	return i18n.N(n, "%d commit", "%d commits")
+}
+
+// renderActivityTable lists the totals per month over the range of the
+// heatmap with the busiest day of each month, in place of the heatmap.
+func renderActivityTable(days map[string]int, opts HeatmapOptions) string {
+	today := heatmapToday()
+	spans := opts.spans(days, today)
+	start, end := spans[0].from, spans[len(spans)-1].to
+	if end.After(today) {
+		end = today
+	}
+	if opts.HistoryStart != "" {
+		if known, err := time.Parse("2006-01-02", opts.HistoryStart); err == nil && known.After(start) {
+			start = known
+		}
+	}
+
+	type month struct {
+		name    string
+		commits int
+		busiest time.Time
+		most    int
+	}
+	var months []*month
+	var weekdays [7]int
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		name := i18n.Month(d.Month()) + " " + d.Format("2006")
+		if len(months) == 0 || months[len(months)-1].name != name {
+			months = append(months, &month{name: name})
+		}
+		m := months[len(months)-1]
+		c := days[d.Format("2006-01-02")]
+		m.commits += c
+		weekdays[d.Weekday()] += c
+		if c > m.most {
+			m.busiest, m.most = d, c
+		}
+	}
+
+	period := opts.period(spans, 0, 0) + ", " + i18n.T("by month")
+	lines := []string{titleStyle.Render(opts.title()) + dimStyle.Render(" ("+period+")")}
+	if opts.HistoryStart != "" {
+		lines[0] += dimStyle.Render(" (" + i18n.T("shallow, history starts") + " " + opts.HistoryStart + ")")
+	}
+	// Month names vary in length and may be wide, so pad by display width.
+	nameWidth := lipgloss.Width(i18n.T("Month"))
+	for _, m := range months {
+		nameWidth = max(nameWidth, lipgloss.Width(m.name))
+	}
+	column := metricColumn(opts.metric())
+	columnWidth := max(7, lipgloss.Width(column))
+	lines = append(lines, dimStyle.Render(fmt.Sprintf("  %s %s  %s", padRight(i18n.T("Month"), nameWidth), padLeft(column, columnWidth), i18n.T("Busiest day"))))
+	for _, m := range months {
+		busiest := "-"
+		if m.most > 0 {
+			d := m.busiest
+			busiest = fmt.Sprintf("%s %d %s (%d)", i18n.Weekday(d.Weekday()), d.Day(), i18n.Month(d.Month()), m.most)
+		}
+		lines = append(lines, fmt.Sprintf("  %s %*d  %s", padRight(m.name, nameWidth), columnWidth, m.commits, busiest))
+	}
+
+	busiestDay, most := time.Sunday, 0
+	for wd, c := range weekdays {
+		if c > most {
+			busiestDay, most = time.Weekday(wd), c
+		}
+	}
+	if most > 0 {
+		lines = append(lines, fmt.Sprintf("  %s %s (%s)", i18n.T("Busiest weekday:"), i18n.Weekday(busiestDay), metricCount(opts.metric(), most)))
+	}
+	return "\n" + strings.Join(lines, "\n")
+}
@@ -0,0 +1,8 @@
+//go:build !unix
+
+package ui
+
+// CellSize returns 0, 0: the console doesn't report pixel sizes.
+func CellSize(fd uintptr) (width, height int) {
+	return 0, 0
+}
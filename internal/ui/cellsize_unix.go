@@ -0,0 +1,16 @@
+//go:build unix
+
+package ui
+
+import "golang.org/x/sys/unix"
+
+// CellSize returns the size of a cell in pixels of the terminal on fd, from
+// the pixel dimensions the terminal reports with its window size, or 0, 0
+// when it doesn't report them.
+func CellSize(fd uintptr) (width, height int) {
+	ws, err := unix.IoctlGetWinsize(int(fd), unix.TIOCGWINSZ)
+	if err != nil || ws.Col == 0 || ws.Row == 0 || ws.Xpixel == 0 || ws.Ypixel == 0 {
+		return 0, 0
+	}
+	return int(ws.Xpixel) / int(ws.Col), int(ws.Ypixel) / int(ws.Row)
+}
@@ -0,0 +1,151 @@
+package ui
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/jpeg" // register JPEG for image.Decode
+	_ "image/png"  // register PNG for image.Decode
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Custom logo bounds in terminal cells. Images are scaled to fit, two
+// pixels per cell vertically.
+const (
+	logoMaxCols      = 40
+	logoMaxRows      = 20
+	smallLogoMaxCols = 8
+	smallLogoMaxRows = 3
+)
+
+// CustomLogo is a user-supplied logo: ASCII art in the same {N} color token
+// format as the built-in logos, or an image drawn with half blocks.
+type CustomLogo struct {
+	art    string
+	colors []string
+	img    image.Image
+}
+
+// LoadLogo reads a PNG or JPEG image, or a text file with ASCII art. Colors
+// fill the art's {N} tokens; without them the language logo's colors are
+// used.
+func LoadLogo(path string, colors []string) (*CustomLogo, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	switch {
+	case err == nil:
+		return &CustomLogo{img: img}, nil
+	case !errors.Is(err, image.ErrFormat):
+		return nil, fmt.Errorf("%s: %w", path, err)
+	case !utf8.Valid(data) || bytes.IndexByte(data, 0) >= 0:
+		return nil, fmt.Errorf("%s: not a PNG, JPEG or text file", path)
+	}
+	art := strings.TrimRight(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n")
+	return &CustomLogo{art: art, colors: colors}, nil
+}
+
+// Render draws the logo, scaling images down to the small size for the
+// compact layout. language picks the colors for art without its own.
+func (l *CustomLogo) Render(language string, small bool) string {
+	if l.img != nil {
+		if small {
+			return renderHalfBlocks(l.img, smallLogoMaxCols, smallLogoMaxRows)
+		}
+		return renderHalfBlocks(l.img, logoMaxCols, logoMaxRows)
+	}
+	colors := l.colors
+	if len(colors) == 0 {
+		colors = getLanguageLogo(language).colors
+	}
+	return renderColoredArt(l.art, colors)
+}
+
+// renderHalfBlocks scales img to fit cols×rows cells and draws each cell as
+// an upper half block, the top pixel in the foreground and the bottom one
+// in the background. Transparent pixels are left blank.
+func renderHalfBlocks(img image.Image, cols, rows int) string {
+	px := scaleImage(img, cols, rows*2)
+	bounds := px.Bounds()
+
+	hex := func(x, y int) string {
+		if y >= bounds.Max.Y {
+			return ""
+		}
+		c := px.NRGBAAt(x, y)
+		if c.A == 0 {
+			return ""
+		}
+		return fmt.Sprintf("#%02X%02X%02X", c.R, c.G, c.B)
+	}
+
+	var b strings.Builder
+	for y := 0; y < bounds.Max.Y; y += 2 {
+		if y > 0 {
+			b.WriteString("\n")
+		}
+		for x := 0; x < bounds.Max.X; x++ {
+			top, bottom := hex(x, y), hex(x, y+1)
+			style := lipgloss.NewStyle()
+			switch {
+			case top == "" && bottom == "":
+				b.WriteString(" ")
+				continue
+			case top == "":
+				b.WriteString(style.Foreground(lipgloss.Color(bottom)).Render(glyphs.Bottom))
+				continue
+			case bottom != "" && (!ColorEnabled() || ASCII()):
+				// Without a background color, fill the cell in the top color
+				b.WriteString(style.Foreground(lipgloss.Color(top)).Render(glyphs.Full))
+				continue
+			case bottom != "":
+				style = style.Background(lipgloss.Color(bottom))
+			}
+			b.WriteString(style.Foreground(lipgloss.Color(top)).Render(glyphs.Top))
+		}
+	}
+	return b.String()
+}
+
+// scaleImage resizes img to fit within w×h pixels, keeping its aspect
+// ratio, by averaging the source pixels behind each target pixel. Mostly
+// transparent pixels become fully transparent, the rest opaque.
+func scaleImage(img image.Image, w, h int) *image.NRGBA {
+	bounds := img.Bounds()
+	sw, sh := bounds.Dx(), bounds.Dy()
+	if sw == 0 || sh == 0 {
+		return image.NewNRGBA(image.Rect(0, 0, 0, 0))
+	}
+	scale := min(float64(w)/float64(sw), float64(h)/float64(sh))
+	tw, th := max(1, int(float64(sw)*scale+0.5)), max(1, int(float64(sh)*scale+0.5))
+
+	px := image.NewNRGBA(image.Rect(0, 0, tw, th))
+	for ty := 0; ty < th; ty++ {
+		y0, y1 := ty*sh/th, max((ty+1)*sh/th, ty*sh/th+1)
+		for tx := 0; tx < tw; tx++ {
+			x0, x1 := tx*sw/tw, max((tx+1)*sw/tw, tx*sw/tw+1)
+			var r, g, b, a, n uint64
+			for y := y0; y < y1; y++ {
+				for x := x0; x < x1; x++ {
+					// RGBA returns alpha-premultiplied 16-bit channels
+					pr, pg, pb, pa := img.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+					r, g, b, a = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa)
+					n++
+				}
+			}
+			if a < n*0x8000 {
+				continue
+			}
+			px.SetNRGBA(tx, ty, color.NRGBA{uint8(r * 0xFF / a), uint8(g * 0xFF / a), uint8(b * 0xFF / a), 0xFF})
+		}
+	}
+	return px
+}
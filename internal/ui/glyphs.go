@@ -0,0 +1,168 @@
+package ui
+
+import (
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/fayssal-elmofatiche/gfetch/internal/git"
+	"github.com/muesli/termenv"
+)
+
+// Color modes for --color.
+const (
+	ColorAuto   = "auto"
+	ColorAlways = "always"
+	ColorNever  = "never"
+)
+
+// Glyphs are the characters drawn for bars, charts and markers.
+type Glyphs struct {
+	Block  string   // bar segments and active heatmap days
+	Empty  string   // inactive heatmap days
+	Fills  []string // bar patterns telling languages apart without color
+	Levels []string // heatmap intensities 0-4 without color
+	Spark  []string // sparkline levels, lowest first
+
+	Up, Down, Left, Right string // trends, ahead/behind and navigation
+
+	Top, Bottom, Full string // half and full blocks for images
+
+	Dot      string // legend marker
+	Stash    string // stash count in the prompt
+	Cursor   string // selected item in the TUI
+	Sep      string // separator on the one-line summary
+	Times    string // multiplication sign
+	Ellipsis string
+}
+
+var unicodeGlyphs = Glyphs{
+	Block:    "█",
+	Empty:    "░",
+	Fills:    []string{"█", "▓", "▒", "░"},
+	Levels:   []string{"·", "░", "▒", "▓", "█"},
+	Spark:    []string{"▁", "▂", "▃", "▄", "▅", "▆", "▇", "█"},
+	Up:       "↑",
+	Down:     "↓",
+	Left:     "←",
+	Right:    "→",
+	Top:      "▀",
+	Bottom:   "▄",
+	Full:     "█",
+	Dot:      "●",
+	Stash:    "≡",
+	Cursor:   "›",
+	Sep:      " • ",
+	Times:    "×",
+	Ellipsis: "…",
+}
+
+var asciiGlyphs = Glyphs{
+	Block:    "#",
+	Empty:    ".",
+	Fills:    []string{"#", "=", "+", ":"},
+	Levels:   []string{".", ":", "+", "*", "#"},
+	Spark:    []string{"_", ".", "-", "~", "=", "+", "*", "#"},
+	Up:       "^",
+	Down:     "v",
+	Left:     "<-",
+	Right:    "->",
+	Top:      "'",
+	Bottom:   ".",
+	Full:     "#",
+	Dot:      "*",
+	Stash:    "$",
+	Cursor:   ">",
+	Sep:      " | ",
+	Times:    "x",
+	Ellipsis: "...",
+}
+
+var glyphs = unicodeGlyphs
+
+// SetASCII switches every renderer to plain ASCII glyphs, for terminals and
+// log collectors that mangle Unicode.
+func SetASCII(ascii bool) {
+	glyphs = unicodeGlyphs
+	if ascii {
+		glyphs = asciiGlyphs
+	}
+}
+
+// ASCII reports whether plain ASCII glyphs are in use.
+func ASCII() bool {
+	return glyphs.Block == asciiGlyphs.Block
+}
+
+// CurrentGlyphs returns the glyph set in use.
+func CurrentGlyphs() Glyphs {
+	return glyphs
+}
+
+// SetColorMode sets whether output is colored. ColorAuto colors terminals
+// unless NO_COLOR is set, ColorAlways colors even redirected output, and
+// ColorNever turns colors and text attributes off.
+func SetColorMode(mode string) {
+	switch mode {
+	case ColorAlways:
+		profile := termenv.NewOutput(os.Stdout, termenv.WithTTY(true)).ColorProfile()
+		if profile == termenv.Ascii {
+			profile = termenv.ANSI
+		}
+		lipgloss.SetColorProfile(profile)
+	case ColorNever:
+		lipgloss.SetColorProfile(termenv.Ascii)
+	}
+}
+
+// ColorEnabled reports whether styles emit colors. Without them, charts
+// fall back to glyphs that show levels by shape.
+func ColorEnabled() bool {
+	return lipgloss.ColorProfile() != termenv.Ascii
+}
+
+// sparkline renders counts as glyphs scaled against peak.
+func sparkline(counts []int, peak int) string {
+	var spark strings.Builder
+	top := len(glyphs.Spark) - 1
+	for _, c := range counts {
+		idx := 0
+		if peak > 0 {
+			idx = min(int(float64(c)/float64(peak)*float64(top)), top)
+		}
+		spark.WriteString(glyphs.Spark[idx])
+	}
+	return spark.String()
+}
+
+// peakOf returns the largest count across series, to scale sparklines that
+// are shown side by side against the same peak.
+func peakOf(series ...[]int) int {
+	peak := 0
+	for _, counts := range series {
+		for _, c := range counts {
+			peak = max(peak, c)
+		}
+	}
+	return peak
+}
+
+// trendGlyph draws a velocity trend as an arrow.
+func trendGlyph(trend string) string {
+	switch trend {
+	case git.TrendUp:
+		return glyphs.Up
+	case git.TrendDown:
+		return glyphs.Down
+	}
+	return glyphs.Right
+}
+
+// fillGlyph returns the bar glyph for the i-th series, such as a language.
+// With colors every series is a solid block; without, each gets a pattern.
+func fillGlyph(i int) string {
+	if ColorEnabled() {
+		return glyphs.Block
+	}
+	return glyphs.Fills[i%len(glyphs.Fills)]
+}
@@ -0,0 +1,260 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+	"strings"
+)
+
+// Terminal graphics protocols for image logos.
+const (
+	ImageAuto  = "auto"
+	ImageNone  = "none"
+	ImageKitty = "kitty"
+	ImageITerm = "iterm"
+	ImageSixel = "sixel"
+)
+
+// Size of a terminal cell in pixels, as set by SetCellSize; 0 when unknown.
+// Sixel images are sized in pixels, so they need it to line up with the
+// info panel. Kitty and iTerm2 scale images to a number of cells
+// themselves, and only use it for the resolution sent.
+var cellWidthPx, cellHeightPx int
+
+// Resolution of a cell for Kitty and iTerm2 images when the real cell size
+// is unknown.
+const (
+	defaultCellWidthPx  = 10
+	defaultCellHeightPx = 20
+)
+
+// kittyChunk is the largest base64 payload per Kitty graphics command.
+const kittyChunk = 4096
+
+// DetectImageProtocol guesses the graphics protocol of the terminal from
+// TERM, TERM_PROGRAM and related variables, or returns ImageNone. Inside
+// tmux or screen, which don't pass images through, it always returns
+// ImageNone.
+func DetectImageProtocol() string {
+	term := os.Getenv("TERM")
+	program := os.Getenv("TERM_PROGRAM")
+	switch {
+	case os.Getenv("TMUX") != "" || strings.HasPrefix(term, "screen") || strings.HasPrefix(term, "tmux"):
+		return ImageNone
+	case term == "xterm-kitty" || os.Getenv("KITTY_WINDOW_ID") != "" || program == "ghostty" || term == "xterm-ghostty":
+		return ImageKitty
+	case program == "iTerm.app" || program == "WezTerm" || os.Getenv("LC_TERMINAL") == "iTerm2":
+		return ImageITerm
+	case strings.HasPrefix(term, "foot") || strings.HasPrefix(term, "mlterm") ||
+		strings.HasPrefix(term, "contour") || strings.Contains(term, "sixel"):
+		return ImageSixel
+	}
+	return ImageNone
+}
+
+// SetCellSize sets the size of a terminal cell in pixels, such as from
+// CellSize. Without it, Sixel images are not drawn.
+func SetCellSize(width, height int) {
+	cellWidthPx, cellHeightPx = width, height
+}
+
+// IsImage reports whether the logo was loaded from an image rather than
+// ASCII art, so it can be drawn with a graphics protocol.
+func (l *CustomLogo) IsImage() bool {
+	return l.img != nil
+}
+
+// Size returns the cells an image logo takes up, the same as its half-block
+// rendering.
+func (l *CustomLogo) Size(small bool) (cols, rows int) {
+	cols, rows = logoMaxCols, logoMaxRows
+	if small {
+		cols, rows = smallLogoMaxCols, smallLogoMaxRows
+	}
+	bounds := scaleImage(l.img, cols, rows*2).Bounds()
+	return bounds.Dx(), (bounds.Dy() + 1) / 2
+}
+
+// Placeholder returns a blank block the size of the logo, to lay out the
+// info panel next to an image drawn later with InlineImage.
+func (l *CustomLogo) Placeholder(small bool) string {
+	cols, rows := l.Size(small)
+	line := strings.Repeat(" ", cols)
+	return strings.TrimSuffix(strings.Repeat(line+"\n", rows), "\n")
+}
+
+// InlineImage returns the escape sequence that draws the logo with the
+// given protocol at the cursor position, or "" for Sixel when the cell
+// size is unknown.
+func (l *CustomLogo) InlineImage(protocol string, small bool) string {
+	cols, rows := l.Size(small)
+	w, h := cellWidthPx, cellHeightPx
+	if w <= 0 || h <= 0 {
+		if protocol == ImageSixel {
+			return ""
+		}
+		w, h = defaultCellWidthPx, defaultCellHeightPx
+	}
+	switch protocol {
+	case ImageKitty:
+		return kittyImage(scaleImage(l.img, cols*w, rows*h), cols, rows)
+	case ImageITerm:
+		return itermImage(scaleImage(l.img, cols*w, rows*h), cols, rows)
+	case ImageSixel:
+		return sixelImage(scaleImage(l.img, cols*w, rows*h))
+	}
+	return ""
+}
+
+// OverlayImage returns seq wrapped to draw at the start of the line lines
+// rows above the cursor, and to put the cursor back afterwards. It is
+// appended to output that has already been printed with a placeholder.
+func OverlayImage(seq string, lines int) string {
+	if seq == "" {
+		return ""
+	}
+	up := ""
+	if lines > 0 {
+		up = fmt.Sprintf("\x1b[%dA", lines)
+	}
+	return "\x1b7" + up + "\r" + seq + "\x1b8"
+}
+
+// ClearImages returns the escape sequence that removes images drawn
+// earlier, for redraws. Only Kitty keeps images when the screen is cleared.
+func ClearImages(protocol string) string {
+	if protocol == ImageKitty {
+		return "\x1b_Ga=d,q=2\x1b\\"
+	}
+	return ""
+}
+
+func encodePNG(img image.Image) string {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes())
+}
+
+// kittyImage transmits img as PNG and places it over cols×rows cells
+// without moving the cursor.
+func kittyImage(img image.Image, cols, rows int) string {
+	data := encodePNG(img)
+	if data == "" {
+		return ""
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(data); i += kittyChunk {
+		end := min(i+kittyChunk, len(data))
+		more := 0
+		if end < len(data) {
+			more = 1
+		}
+		if i == 0 {
+			fmt.Fprintf(&b, "\x1b_Ga=T,f=100,q=2,C=1,c=%d,r=%d,m=%d;%s\x1b\\", cols, rows, more, data[i:end])
+		} else {
+			fmt.Fprintf(&b, "\x1b_Gm=%d;%s\x1b\\", more, data[i:end])
+		}
+	}
+	return b.String()
+}
+
+// itermImage sends img as an iTerm2 inline PNG sized to cols×rows cells.
+func itermImage(img image.Image, cols, rows int) string {
+	data := encodePNG(img)
+	if data == "" {
+		return ""
+	}
+	size := base64.RawStdEncoding.DecodedLen(len(strings.TrimRight(data, "=")))
+	return fmt.Sprintf("\x1b]1337;File=inline=1;size=%d;width=%d;height=%d;preserveAspectRatio=1:%s\a", size, cols, rows, data)
+}
+
+// sixelImage encodes img as Sixel graphics with colors reduced to a 6×6×6
+// cube. Transparent pixels are left unpainted.
+func sixelImage(img *image.NRGBA) string {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	if w == 0 || h == 0 {
+		return ""
+	}
+
+	// Palette index per pixel, -1 for transparent
+	level := func(c uint8) int { return (int(c)*5 + 127) / 255 }
+	idx := make([]int, w*h)
+	used := make([]bool, 216)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := img.NRGBAAt(x, y)
+			if c.A == 0 {
+				idx[y*w+x] = -1
+				continue
+			}
+			i := level(c.R)*36 + level(c.G)*6 + level(c.B)
+			idx[y*w+x] = i
+			used[i] = true
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "\x1bP0;1;0q\"1;1;%d;%d", w, h)
+	for i, ok := range used {
+		if ok {
+			fmt.Fprintf(&b, "#%d;2;%d;%d;%d", i, i/36*20, i/6%6*20, i%6*20)
+		}
+	}
+
+	row := make([]byte, w)
+	for y0 := 0; y0 < h; y0 += 6 {
+		// One pass per color in this band of six pixel rows
+		colors := map[int]bool{}
+		for y := y0; y < min(y0+6, h); y++ {
+			for x := 0; x < w; x++ {
+				if i := idx[y*w+x]; i >= 0 {
+					colors[i] = true
+				}
+			}
+		}
+		for i := range used {
+			if !colors[i] {
+				continue
+			}
+			for x := 0; x < w; x++ {
+				bits := 0
+				for k := 0; k < 6 && y0+k < h; k++ {
+					if idx[(y0+k)*w+x] == i {
+						bits |= 1 << k
+					}
+				}
+				row[x] = byte(63 + bits)
+			}
+			fmt.Fprintf(&b, "#%d", i)
+			writeSixelRun(&b, row)
+			b.WriteByte('$')
+		}
+		b.WriteByte('-')
+	}
+	b.WriteString("\x1b\\")
+	return b.String()
+}
+
+// writeSixelRun writes a row of sixels, run-length encoding repeats.
+func writeSixelRun(b *strings.Builder, row []byte) {
+	for i := 0; i < len(row); {
+		j := i
+		for j < len(row) && row[j] == row[i] {
+			j++
+		}
+		if n := j - i; n > 3 {
+			fmt.Fprintf(b, "!%d%c", n, row[i])
+		} else {
+			b.Write(row[i:j])
+		}
+		i = j
+	}
+}
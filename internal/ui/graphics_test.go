@@ -0,0 +1,213 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/base64"
+	"flag"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files in testdata")
+
+// golden compares got with testdata/name, or rewrites it with -update.
+func golden(t *testing.T, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != string(want) {
+		t.Errorf("%s mismatch:\ngot  %q\nwant %q", name, got, want)
+	}
+}
+
+// testImage is a w×h image with a red top half, a blue bottom half and a
+// transparent first column.
+func testImage(w, h int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 1; x < w; x++ {
+			c := color.NRGBA{R: 255, A: 255}
+			if y >= h/2 {
+				c = color.NRGBA{B: 255, A: 255}
+			}
+			img.SetNRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+// noisyImage is a w×h image that compresses poorly, for payloads spanning
+// several Kitty chunks.
+func noisyImage(w, h int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	seed := uint32(1)
+	for i := range img.Pix {
+		seed = seed*1664525 + 1013904223
+		img.Pix[i] = byte(seed >> 24)
+	}
+	return img
+}
+
+// decodePayload checks that data is a base64 PNG of img.
+func decodePayload(t *testing.T, data string, img *image.NRGBA) {
+	t.Helper()
+	raw, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		t.Fatalf("payload is not base64: %v", err)
+	}
+	decoded, err := png.Decode(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatalf("payload is not a PNG: %v", err)
+	}
+	if decoded.Bounds() != img.Bounds() {
+		t.Fatalf("payload bounds = %v, want %v", decoded.Bounds(), img.Bounds())
+	}
+}
+
+// payloadRe matches a base64 payload after the ';' of a Kitty command or
+// the ':' of an iTerm2 one.
+var payloadRe = regexp.MustCompile(`([;:])([A-Za-z0-9+/=]+)(\x1b\\|\a)`)
+
+var sizeRe = regexp.MustCompile(`size=\d+`)
+
+// framing replaces base64 payloads with <payload> and their sizes with <n>,
+// leaving the escape sequences around them to compare against golden files.
+func framing(seq string) string {
+	seq = payloadRe.ReplaceAllString(seq, "$1<payload>$3")
+	return sizeRe.ReplaceAllString(seq, "size=<n>")
+}
+
+func TestKittyImage(t *testing.T) {
+	img := noisyImage(64, 64)
+	seq := kittyImage(img, 8, 4)
+
+	chunks := regexp.MustCompile(`\x1b_G([^;]*);([^\x1b]*)\x1b\\`).FindAllStringSubmatch(seq, -1)
+	if len(chunks) < 3 {
+		t.Fatalf("got %d chunks, want several for a %d byte payload", len(chunks), len(encodePNG(img)))
+	}
+	var data strings.Builder
+	for i, c := range chunks {
+		if len(c[2]) > kittyChunk {
+			t.Errorf("chunk %d has %d bytes, more than %d", i, len(c[2]), kittyChunk)
+		}
+		more := "m=1"
+		if i == len(chunks)-1 {
+			more = "m=0"
+		}
+		if !strings.HasSuffix(c[1], more) {
+			t.Errorf("chunk %d control %q, want it to end in %s", i, c[1], more)
+		}
+		data.WriteString(c[2])
+	}
+	if want := "a=T,f=100,q=2,C=1,c=8,r=4,m=1"; chunks[0][1] != want {
+		t.Errorf("first chunk control = %q, want %q", chunks[0][1], want)
+	}
+	decodePayload(t, data.String(), img)
+}
+
+func TestKittyImageSingleChunk(t *testing.T) {
+	golden(t, "kitty.golden", framing(kittyImage(testImage(4, 4), 2, 1)))
+}
+
+func TestITermImage(t *testing.T) {
+	img := testImage(20, 40)
+	seq := itermImage(img, 2, 2)
+	golden(t, "iterm.golden", framing(seq))
+
+	data := payloadRe.FindStringSubmatch(seq)[2]
+	decodePayload(t, data, img)
+	raw, _ := base64.StdEncoding.DecodeString(data)
+	if want := "size=" + strconv.Itoa(len(raw)); sizeRe.FindString(seq) != want {
+		t.Errorf("iTerm2 %s, want %s", sizeRe.FindString(seq), want)
+	}
+}
+
+func TestSixelImage(t *testing.T) {
+	golden(t, "sixel.golden", sixelImage(testImage(8, 12)))
+}
+
+func TestSixelImageEmpty(t *testing.T) {
+	if got := sixelImage(image.NewNRGBA(image.Rect(0, 0, 0, 0))); got != "" {
+		t.Errorf("sixelImage(empty) = %q, want \"\"", got)
+	}
+}
+
+func TestWriteSixelRun(t *testing.T) {
+	tests := []struct {
+		row  string
+		want string
+	}{
+		{"", ""},
+		{"?", "?"},
+		{"???", "???"},
+		{"????", "!4?"},
+		{"~~~~~~~~~~@@", "!10~@@"},
+		{"?@?@", "?@?@"},
+		{"AAAAABBBBB", "!5A!5B"},
+	}
+	for _, tt := range tests {
+		var b strings.Builder
+		writeSixelRun(&b, []byte(tt.row))
+		if got := b.String(); got != tt.want {
+			t.Errorf("writeSixelRun(%q) = %q, want %q", tt.row, got, tt.want)
+		}
+	}
+}
+
+func TestInlineImageCellSize(t *testing.T) {
+	defer SetCellSize(cellWidthPx, cellHeightPx)
+	logo := &CustomLogo{img: testImage(40, 40)}
+
+	SetCellSize(0, 0)
+	if got := logo.InlineImage(ImageSixel, true); got != "" {
+		t.Errorf("sixel with unknown cell size = %q, want \"\"", got)
+	}
+	if got := logo.InlineImage(ImageKitty, true); got == "" {
+		t.Error("kitty with unknown cell size is empty, want the default resolution")
+	}
+
+	// The image must cover no more pixels than its placeholder's cells
+	for _, size := range [][2]int{{8, 16}, {10, 20}, {13, 27}} {
+		SetCellSize(size[0], size[1])
+		cols, rows := logo.Size(true)
+		seq := logo.InlineImage(ImageSixel, true)
+		w, h := sixelSize(t, seq)
+		if w > cols*size[0] || h > rows*size[1] {
+			t.Errorf("cell %v: sixel is %d×%d px, more than %d×%d cells", size, w, h, cols, rows)
+		}
+		if w < cols*size[0]-size[0] && h < rows*size[1]-size[1] {
+			t.Errorf("cell %v: sixel is %d×%d px, leaving a gap in %d×%d cells", size, w, h, cols, rows)
+		}
+	}
+}
+
+var rasterRe = regexp.MustCompile(`"1;1;(\d+);(\d+)`)
+
+// sixelSize reads the width and height in pixels from the raster
+// attributes of a Sixel image.
+func sixelSize(t *testing.T, seq string) (w, h int) {
+	t.Helper()
+	m := rasterRe.FindStringSubmatch(seq)
+	if m == nil {
+		t.Fatalf("no raster attributes in %q", seq)
+	}
+	w, _ = strconv.Atoi(m[1])
+	h, _ = strconv.Atoi(m[2])
+	return w, h
+}
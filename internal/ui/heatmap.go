@@ -2,10 +2,14 @@ package ui
 
 import (
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/fayssal-elmofatiche/gfetch/internal/git"
+	"github.com/fayssal-elmofatiche/gfetch/internal/i18n"
 )
 
 var greenLevels = []string{
@@ -16,16 +20,101 @@ var greenLevels = []string{
 	"#39d353", // 4: high
 }
 
+// Heatmap scales, how a day's value maps to an activity level.
 const (
-	emptyBlock = "░"
-	fullBlock  = "█"
+	ScaleLinear = "linear" // quartiles of the busiest day
+	ScaleLog    = "log"    // quartiles on a log scale, so one huge day doesn't flatten the rest
+	ScaleFixed  = "fixed"  // HeatmapOptions.Thresholds
 )
 
-func commitLevel(count, max int) int {
-	if count == 0 || max == 0 {
+// defaultThresholds are the smallest values of levels 1-4 with ScaleFixed,
+// per metric.
+var defaultThresholds = map[string][4]int{
+	git.MetricCommits: {1, 3, 6, 10},
+	git.MetricLines:   {1, 100, 500, 2000},
+	git.MetricAuthors: {1, 2, 3, 5},
+}
+
+// colorBlock draws a day at an activity level: a block in the level's
+// color, or without colors a glyph whose shape shows the level.
+func colorBlock(level int) string {
+	if !ColorEnabled() {
+		return glyphs.Levels[level]
+	}
+	ch := glyphs.Block
+	if level == 0 {
+		ch = glyphs.Empty
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(greenLevels[level])).Render(ch)
+}
+
+type heatmapCell struct {
+	date  time.Time
+	count int
+	valid bool
+}
+
+// HeatmapOptions controls the range, size and scale of the heatmap.
+type HeatmapOptions struct {
+	// HistoryStart is the date of the oldest available commit in a shallow
+	// clone, or "" for full history. Days before it are left blank rather
+	// than shown as inactive.
+	HistoryStart string
+
+	// Width is the number of columns available; 0 means unlimited. Narrow
+	// widths pack weeks into one column each, then show fewer weeks.
+	Width int
+
+	// HalfHeight draws two days per row with half blocks, for short
+	// terminals.
+	HalfHeight bool
+
+	// Months shows the last N months instead of the past year, Year a
+	// calendar year, and AllYears one calendar year per grid, stacked,
+	// from the first with history. At most one is set.
+	Months   int
+	Year     int
+	AllYears bool
+
+	// MondayFirst starts weeks on Monday instead of Sunday.
+	MondayFirst bool
+
+	// Metric is what a day's value counts, one of git's Metric constants;
+	// "" means commits. It names the heatmap and picks default thresholds.
+	Metric string
+
+	// Scale is ScaleLinear (the default), ScaleLog or ScaleFixed. With
+	// ScaleFixed, Thresholds are the smallest values of levels 1-4; zero
+	// means the defaults for Metric.
+	Scale      string
+	Thresholds [4]int
+}
+
+// level maps a day's value to an activity level from 0 (none) to 4, given
+// the busiest day on the heatmap.
+func (o HeatmapOptions) level(count, peak int) int {
+	if count <= 0 || peak <= 0 {
 		return 0
 	}
-	ratio := float64(count) / float64(max)
+	var ratio float64
+	switch o.Scale {
+	case ScaleFixed:
+		thresholds := o.Thresholds
+		if thresholds == ([4]int{}) {
+			thresholds = defaultThresholds[o.metric()]
+		}
+		level := 0
+		for i, t := range thresholds {
+			if count >= t {
+				level = i + 1
+			}
+		}
+		return level
+	case ScaleLog:
+		ratio = math.Log1p(float64(count)) / math.Log1p(float64(peak))
+	default:
+		ratio = float64(count) / float64(peak)
+	}
 	switch {
 	case ratio <= 0.25:
 		return 1
@@ -38,61 +127,227 @@ func commitLevel(count, max int) int {
 	}
 }
 
-func colorBlock(level int) string {
-	ch := fullBlock
-	if level == 0 {
-		ch = emptyBlock
+func (o HeatmapOptions) metric() string {
+	if o.Metric == "" {
+		return git.MetricCommits
 	}
-	return lipgloss.NewStyle().Foreground(lipgloss.Color(greenLevels[level])).Render(ch)
+	return o.Metric
 }
 
-type heatmapCell struct {
-	date  time.Time
-	count int
-	valid bool
+// title names the heatmap after its metric.
+func (o HeatmapOptions) title() string {
+	switch o.metric() {
+	case git.MetricLines:
+		return i18n.T("Lines Changed")
+	case git.MetricAuthors:
+		return i18n.T("Author Activity")
+	}
+	return i18n.T("Commit Activity")
+}
+
+// heatmapSpan is a range of days drawn as one grid.
+type heatmapSpan struct {
+	from, to time.Time
+	label    string // shown in the weekday column when grids are stacked
+}
+
+// spans returns the ranges of days to draw, oldest first.
+func (o HeatmapOptions) spans(days map[string]int, today time.Time) []heatmapSpan {
+	switch {
+	case o.Months > 0:
+		return []heatmapSpan{{from: today.AddDate(0, -o.Months, 1), to: today}}
+	case o.Year > 0:
+		return []heatmapSpan{yearSpan(o.Year, "")}
+	case o.AllYears:
+		first := today.Year()
+		for d := range days {
+			if y, err := strconv.Atoi(d[:min(4, len(d))]); err == nil && y < first {
+				first = y
+			}
+		}
+		if known, err := time.Parse("2006-01-02", o.HistoryStart); err == nil {
+			first = max(first, known.Year())
+		}
+		var spans []heatmapSpan
+		for y := first; y <= today.Year(); y++ {
+			spans = append(spans, yearSpan(y, strconv.Itoa(y)))
+		}
+		return spans
+	}
+	return []heatmapSpan{{from: today.AddDate(0, 0, -364), to: today}}
 }
 
-func RenderHeatmap(dates []string) string {
-	counts := make(map[string]int)
-	for _, d := range dates {
-		counts[d]++
+// Since returns the first day the heatmap shows, to limit how much history
+// is read, or the zero time when it stacks every year.
+func (o HeatmapOptions) Since() time.Time {
+	if o.AllYears {
+		return time.Time{}
 	}
+	return o.spans(nil, heatmapToday())[0].from
+}
 
-	today := time.Now().Truncate(24 * time.Hour)
-	oneYearAgo := today.AddDate(0, 0, -364)
+func yearSpan(year int, label string) heatmapSpan {
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	return heatmapSpan{from: from, to: from.AddDate(1, 0, -1), label: label}
+}
 
-	maxCommits := 0
-	for d := oneYearAgo; !d.After(today); d = d.AddDate(0, 0, 1) {
-		if c := counts[d.Format("2006-01-02")]; c > maxCommits {
-			maxCommits = c
+// period describes the range in the title, given how many weeks of a
+// single span fit.
+func (o HeatmapOptions) period(spans []heatmapSpan, weeks, shown int) string {
+	switch {
+	case o.Year > 0:
+		return strconv.Itoa(o.Year)
+	case o.AllYears:
+		if len(spans) == 1 {
+			return spans[0].label
 		}
+		return spans[0].label + "–" + spans[len(spans)-1].label
+	case shown < weeks:
+		return i18n.N(shown, "past %d week", "past %d weeks")
+	case o.Months > 0:
+		return i18n.N(o.Months, "past %d month", "past %d months")
+	}
+	return i18n.T("past year")
+}
+
+// firstWeekday is the weekday in the top row of the grid.
+func (o HeatmapOptions) firstWeekday() time.Weekday {
+	if o.MondayFirst {
+		return time.Monday
 	}
+	return time.Sunday
+}
+
+// heatmapToday returns today's local date as midnight UTC, so that days
+// step evenly and format as the commit dates git prints.
+func heatmapToday() time.Time {
+	now := time.Now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// dayLabelWidth is the width of the weekday label column ("Mon  ").
+const dayLabelWidth = 5
+
+// heatmapLayout picks the column width of a week and how many of weeks fit
+// in width: two columns per week (block and gap) if they all fit,
+// otherwise one.
+func heatmapLayout(width, weeks int) (cellWidth, fit int) {
+	if width <= 0 || dayLabelWidth+weeks*2 <= width {
+		return 2, weeks
+	}
+	fit = width - dayLabelWidth
+	if fit > weeks {
+		fit = weeks
+	}
+	if fit < 1 {
+		fit = 1
+	}
+	return 1, fit
+}
 
-	// Align start to Sunday
-	start := oneYearAgo
-	for start.Weekday() != time.Sunday {
+// heatmapGrid lays out the days of span in week columns of seven days,
+// starting on first. Days outside the span, in the future or before known
+// are left blank.
+func heatmapGrid(span heatmapSpan, days map[string]int, first time.Weekday, today, known time.Time) [][]heatmapCell {
+	start := span.from
+	for start.Weekday() != first {
 		start = start.AddDate(0, 0, -1)
 	}
 
-	// Build grid: 7 rows (Sun..Sat) x N week-columns
 	var weeks [][]heatmapCell
 	d := start
-	for !d.After(today) {
+	for !d.After(span.to) && !d.After(today) {
 		var week []heatmapCell
 		for i := 0; i < 7; i++ {
-			inRange := !d.Before(oneYearAgo) && !d.After(today)
+			inRange := !d.Before(span.from) && !d.After(span.to) && !d.After(today) && !d.Before(known)
 			c := 0
 			if inRange {
-				c = counts[d.Format("2006-01-02")]
+				c = days[d.Format("2006-01-02")]
 			}
 			week = append(week, heatmapCell{date: d, count: c, valid: inRange})
 			d = d.AddDate(0, 0, 1)
 		}
 		weeks = append(weeks, week)
 	}
+	return weeks
+}
+
+// RenderHeatmap draws the activity per day for the past year, or the range
+// in opts, keeping as many recent weeks as fit in opts.Width.
+func RenderHeatmap(days map[string]int, opts HeatmapOptions) string {
+	if accessible {
+		return renderActivityTable(days, opts)
+	}
+
+	// Half blocks need a foreground and a background color per cell
+	if !ColorEnabled() || ASCII() {
+		opts.HalfHeight = false
+	}
+
+	today := heatmapToday()
+	var known time.Time
+	if opts.HistoryStart != "" {
+		known, _ = time.Parse("2006-01-02", opts.HistoryStart)
+	}
+
+	spans := opts.spans(days, today)
+	grids := make([][][]heatmapCell, len(spans))
+	maxWeeks, peak := 1, 0
+	for i, span := range spans {
+		grids[i] = heatmapGrid(span, days, opts.firstWeekday(), today, known)
+		maxWeeks = max(maxWeeks, len(grids[i]))
+		for _, week := range grids[i] {
+			for _, c := range week {
+				if c.valid {
+					peak = max(peak, c.count)
+				}
+			}
+		}
+	}
+
+	cellWidth, numWeeks := heatmapLayout(opts.Width, maxWeeks)
+	level := func(count int) int { return opts.level(count, peak) }
+
+	var rows []string
+	for i, weeks := range grids {
+		if len(weeks) > numWeeks {
+			weeks = weeks[len(weeks)-numWeeks:]
+		}
+		if i > 0 && !opts.HalfHeight {
+			rows = append(rows, "")
+		}
+		rows = append(rows, renderGrid(weeks, spans[i].label, cellWidth, opts, level)...)
+	}
+
+	// Legend
+	legendStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#8B949E"))
+	var legend strings.Builder
+	legend.WriteString("     ")
+	legend.WriteString(legendStyle.Render(i18n.T("Less") + " "))
+	for i := 0; i < 5; i++ {
+		legend.WriteString(colorBlock(i) + " ")
+	}
+	legend.WriteString(legendStyle.Render(i18n.T("More")))
+
+	if !opts.HalfHeight {
+		rows = append(rows, "")
+	}
+	rows = append(rows, legend.String())
 
+	period := opts.period(spans, maxWeeks, numWeeks)
+	heatmapTitle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#6CB6FF")).Render(opts.title() + " (" + period + ")")
+	if opts.HistoryStart != "" {
+		heatmapTitle += legendStyle.Render(" (" + i18n.T("shallow, history starts") + " " + opts.HistoryStart + ")")
+	}
+
+	return fmt.Sprintf("\n%s\n%s", heatmapTitle, strings.Join(rows, "\n"))
+}
+
+// renderGrid draws the month labels and day rows of one grid, with label
+// in the weekday column of the month row.
+func renderGrid(weeks [][]heatmapCell, label string, cellWidth int, opts HeatmapOptions, level func(int) int) []string {
 	// Build month labels at correct character positions
-	// Each week column = 2 chars wide (block + space)
+	// Each week column = cellWidth chars wide (block, plus a space if 2)
 	// Weekday label column = 5 chars wide ("Mon  ")
 	monthLabelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#8B949E"))
 	dayLabelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#8B949E"))
@@ -108,49 +363,75 @@ func RenderHeatmap(dates []string) string {
 		for _, c := range week {
 			if c.valid && c.date.Month() != lastMonth {
 				lastMonth = c.date.Month()
-				monthPositions = append(monthPositions, monthPos{c.date.Format("Jan"), wi})
+				monthPositions = append(monthPositions, monthPos{i18n.Month(c.date.Month()), wi})
 				break
 			}
 		}
 	}
 
-	// Build month label row using character positions
-	// Each week = 2 chars. Labels are 3 chars. Place them so they don't overlap.
-	totalWidth := 5 + len(weeks)*2
-	monthLine := make([]byte, totalWidth)
-	for i := range monthLine {
-		monthLine[i] = ' '
+	// Build month label row using display cell positions, since localized
+	// names vary in length and may contain wide characters. Place them so
+	// they don't overlap.
+	totalWidth := dayLabelWidth + len(weeks)*cellWidth
+	var monthLine strings.Builder
+	end := 0 // first free cell after the last label
+	if label != "" {
+		monthLine.WriteString(label)
+		end = lipgloss.Width(label)
 	}
-	lastPos := -10 // track last label position to prevent overlap
 	for _, mp := range monthPositions {
-		pos := 5 + mp.col*2
-		name := mp.name
-		// Skip if it would overlap with previous label (need 4 char gap)
-		if pos-lastPos < 4 {
+		pos := dayLabelWidth + mp.col*cellWidth
+		w := lipgloss.Width(mp.name)
+		// Skip if it would touch the previous label or run off the end
+		if pos < end+1 && end > 0 || pos+w > totalWidth {
 			continue
 		}
-		if pos+len(name) <= totalWidth {
-			copy(monthLine[pos:], name)
-			lastPos = pos
-		}
+		monthLine.WriteString(strings.Repeat(" ", pos-end) + mp.name)
+		end = pos + w
 	}
-	monthRow := monthLabelStyle.Render(string(monthLine))
+	monthLine.WriteString(strings.Repeat(" ", max(totalWidth-end, 0)))
+	rows := []string{monthLabelStyle.Render(monthLine.String())}
 
 	// Weekday labels (Mon, Wed, Fri like GitHub)
-	weekdayLabels := map[int]string{
-		1: "Mon",
-		3: "Wed",
-		5: "Fri",
+	weekday := func(row int) time.Weekday {
+		return (opts.firstWeekday() + time.Weekday(row)) % 7
+	}
+	labeled := func(day time.Weekday) bool {
+		return day == time.Monday || day == time.Wednesday || day == time.Friday
 	}
 
-	var rows []string
-	rows = append(rows, monthRow)
+	gap := strings.Repeat(" ", cellWidth-1)
+	if opts.HalfHeight {
+		// Two days per row, e.g. Sun/Mon, Tue/Wed, Thu/Fri, Sat. Each row
+		// is labeled with whichever of its days is Mon, Wed or Fri.
+		for pair := 0; pair < 4; pair++ {
+			var row strings.Builder
+			switch {
+			case labeled(weekday(pair * 2)):
+				row.WriteString(dayLabelStyle.Render(dayLabel(weekday(pair * 2))))
+			case pair*2+1 < 7 && labeled(weekday(pair*2+1)):
+				row.WriteString(dayLabelStyle.Render(dayLabel(weekday(pair*2 + 1))))
+			default:
+				row.WriteString("     ")
+			}
+			for _, week := range weeks {
+				top := week[pair*2]
+				var bottom heatmapCell
+				if pair*2+1 < len(week) {
+					bottom = week[pair*2+1]
+				}
+				row.WriteString(halfBlock(top, bottom, level) + gap)
+			}
+			rows = append(rows, row.String())
+		}
+		return rows
+	}
 
 	for dayIdx := 0; dayIdx < 7; dayIdx++ {
 		var row strings.Builder
 
-		if label, ok := weekdayLabels[dayIdx]; ok {
-			row.WriteString(dayLabelStyle.Render(fmt.Sprintf(" %s ", label)))
+		if day := weekday(dayIdx); labeled(day) {
+			row.WriteString(dayLabelStyle.Render(dayLabel(day)))
 		} else {
 			row.WriteString("     ")
 		}
@@ -159,31 +440,43 @@ func RenderHeatmap(dates []string) string {
 			if dayIdx < len(week) {
 				c := week[dayIdx]
 				if c.valid {
-					level := commitLevel(c.count, maxCommits)
-					row.WriteString(colorBlock(level) + " ")
+					row.WriteString(colorBlock(level(c.count)) + gap)
 				} else {
-					row.WriteString("  ")
+					row.WriteString(" " + gap)
 				}
 			}
 		}
 
 		rows = append(rows, row.String())
 	}
+	return rows
+}
 
-	// Legend
-	legendStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#8B949E"))
-	var legend strings.Builder
-	legend.WriteString("     ")
-	legend.WriteString(legendStyle.Render("Less "))
-	for i := 0; i < 5; i++ {
-		legend.WriteString(colorBlock(i) + " ")
-	}
-	legend.WriteString(legendStyle.Render("More"))
-
-	rows = append(rows, "")
-	rows = append(rows, legend.String())
-
-	heatmapTitle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#6CB6FF")).Render("Commit Activity (past year)")
+// dayLabel renders a weekday name for the label column, trimmed or padded
+// to its width in display cells.
+func dayLabel(day time.Weekday) string {
+	name := lipgloss.NewStyle().MaxWidth(dayLabelWidth - 2).Render(i18n.Weekday(day))
+	return " " + name + strings.Repeat(" ", dayLabelWidth-1-lipgloss.Width(name))
+}
 
-	return fmt.Sprintf("\n%s\n%s", heatmapTitle, strings.Join(rows, "\n"))
+// halfBlock draws two days in one cell: the upper half block in the top
+// day's color over a background in the bottom day's color.
+func halfBlock(top, bottom heatmapCell, level func(int) int) string {
+	style := lipgloss.NewStyle()
+	if !top.valid && !bottom.valid {
+		return " "
+	}
+	if top.valid {
+		style = style.Foreground(lipgloss.Color(greenLevels[level(top.count)]))
+	}
+	if bottom.valid {
+		style = style.Background(lipgloss.Color(greenLevels[level(bottom.count)]))
+	}
+	ch := glyphs.Top
+	if !top.valid {
+		// Only the lower half is in range, e.g. the Sunday before the start
+		ch = glyphs.Bottom
+		style = lipgloss.NewStyle().Foreground(lipgloss.Color(greenLevels[level(bottom.count)]))
+	}
+	return style.Render(ch)
 }
@@ -0,0 +1,205 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fayssal-elmofatiche/gfetch/internal/git"
+)
+
+func TestHeatmapLevel(t *testing.T) {
+	tests := []struct {
+		name  string
+		opts  HeatmapOptions
+		peak  int
+		count []int // values for levels 0-4, in order
+		want  []int
+	}{
+		{
+			name:  "linear",
+			opts:  HeatmapOptions{},
+			peak:  100,
+			count: []int{0, 1, 25, 26, 50, 51, 75, 76, 100},
+			want:  []int{0, 1, 1, 2, 2, 3, 3, 4, 4},
+		},
+		{
+			// log1p(count)/log1p(1000): 3 is 0.20, 9 is 0.33, 60 is 0.60,
+			// 300 is 0.83
+			name:  "log",
+			opts:  HeatmapOptions{Scale: ScaleLog},
+			peak:  1000,
+			count: []int{0, 3, 9, 60, 300},
+			want:  []int{0, 1, 2, 3, 4},
+		},
+		{
+			name:  "fixed defaults for commits",
+			opts:  HeatmapOptions{Scale: ScaleFixed},
+			peak:  1000,
+			count: []int{0, 1, 2, 3, 5, 6, 9, 10, 500},
+			want:  []int{0, 1, 1, 2, 2, 3, 3, 4, 4},
+		},
+		{
+			name:  "fixed defaults for lines",
+			opts:  HeatmapOptions{Scale: ScaleFixed, Metric: git.MetricLines},
+			peak:  5000,
+			count: []int{1, 99, 100, 500, 2000},
+			want:  []int{1, 1, 2, 3, 4},
+		},
+		{
+			name:  "fixed custom thresholds",
+			opts:  HeatmapOptions{Scale: ScaleFixed, Thresholds: [4]int{2, 4, 8, 16}},
+			peak:  20,
+			count: []int{1, 2, 7, 8, 16},
+			want:  []int{0, 1, 2, 3, 4},
+		},
+		{
+			name:  "empty heatmap",
+			opts:  HeatmapOptions{},
+			peak:  0,
+			count: []int{0, 5},
+			want:  []int{0, 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, c := range tt.count {
+				if got := tt.opts.level(c, tt.peak); got != tt.want[i] {
+					t.Errorf("level(%d, %d) = %d, want %d", c, tt.peak, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func date(s string) time.Time {
+	d, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func TestHeatmapGridWeekStart(t *testing.T) {
+	// 2026-10-01 is a Thursday
+	span := heatmapSpan{from: date("2026-10-01"), to: date("2026-10-31")}
+	days := map[string]int{"2026-10-01": 3, "2026-10-05": 1}
+	today := date("2026-10-19")
+
+	for _, first := range []time.Weekday{time.Sunday, time.Monday} {
+		weeks := heatmapGrid(span, days, first, today, time.Time{})
+		for wi, week := range weeks {
+			if len(week) != 7 {
+				t.Fatalf("%v: week %d has %d days", first, wi, len(week))
+			}
+			if got := week[0].date.Weekday(); got != first {
+				t.Errorf("%v: week %d starts on %v", first, wi, got)
+			}
+		}
+		// Padding before the 1st and days after today are blank
+		if c := weeks[0][0]; c.valid {
+			t.Errorf("%v: %s before the span is valid", first, c.date.Format("2006-01-02"))
+		}
+		last := weeks[len(weeks)-1]
+		for _, c := range last {
+			if c.valid != !c.date.After(today) {
+				t.Errorf("%v: %s valid = %v", first, c.date.Format("2006-01-02"), c.valid)
+			}
+		}
+	}
+
+	// Thursday is the fifth row with Sunday first and the fourth with Monday
+	sunday := heatmapGrid(span, days, time.Sunday, today, time.Time{})
+	monday := heatmapGrid(span, days, time.Monday, today, time.Time{})
+	if c := sunday[0][4]; c.count != 3 || !c.valid {
+		t.Errorf("Sunday first: Oct 1 = %+v, want 3 commits in row 4", c)
+	}
+	if c := monday[0][3]; c.count != 3 || !c.valid {
+		t.Errorf("Monday first: Oct 1 = %+v, want 3 commits in row 3", c)
+	}
+	// Monday Oct 5 opens the second week only when weeks start on Monday
+	if c := monday[1][0]; c.date != date("2026-10-05") || c.count != 1 {
+		t.Errorf("Monday first: week 1 starts with %+v", c)
+	}
+}
+
+func TestHeatmapGridHistoryStart(t *testing.T) {
+	span := heatmapSpan{from: date("2026-10-01"), to: date("2026-10-31")}
+	weeks := heatmapGrid(span, nil, time.Sunday, date("2026-10-31"), date("2026-10-10"))
+	for _, week := range weeks {
+		for _, c := range week {
+			inRange := !c.date.Before(date("2026-10-10")) && !c.date.After(date("2026-10-31"))
+			if c.valid != inRange {
+				t.Errorf("%s valid = %v, want %v", c.date.Format("2006-01-02"), c.valid, inRange)
+			}
+		}
+	}
+}
+
+func TestHeatmapSpans(t *testing.T) {
+	today := date("2026-10-19")
+	days := map[string]int{"2024-03-05": 1, "2026-01-02": 2}
+	tests := []struct {
+		name string
+		opts HeatmapOptions
+		want []heatmapSpan
+	}{
+		{
+			name: "past year",
+			opts: HeatmapOptions{},
+			want: []heatmapSpan{{from: date("2025-10-20"), to: today}},
+		},
+		{
+			name: "months",
+			opts: HeatmapOptions{Months: 6},
+			want: []heatmapSpan{{from: date("2026-04-20"), to: today}},
+		},
+		{
+			name: "calendar year",
+			opts: HeatmapOptions{Year: 2025},
+			want: []heatmapSpan{{from: date("2025-01-01"), to: date("2025-12-31")}},
+		},
+		{
+			name: "all years",
+			opts: HeatmapOptions{AllYears: true},
+			want: []heatmapSpan{
+				{from: date("2024-01-01"), to: date("2024-12-31"), label: "2024"},
+				{from: date("2025-01-01"), to: date("2025-12-31"), label: "2025"},
+				{from: date("2026-01-01"), to: date("2026-12-31"), label: "2026"},
+			},
+		},
+		{
+			name: "all years of a shallow clone",
+			opts: HeatmapOptions{AllYears: true, HistoryStart: "2025-06-01"},
+			want: []heatmapSpan{
+				{from: date("2025-01-01"), to: date("2025-12-31"), label: "2025"},
+				{from: date("2026-01-01"), to: date("2026-12-31"), label: "2026"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.opts.spans(days, today)
+			if len(got) != len(tt.want) {
+				t.Fatalf("spans() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("span %d = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestHeatmapSince(t *testing.T) {
+	today := heatmapToday()
+	if got := (HeatmapOptions{AllYears: true}).Since(); !got.IsZero() {
+		t.Errorf("Since() with all years = %v, want the zero time", got)
+	}
+	if got, want := (HeatmapOptions{}).Since(), today.AddDate(0, 0, -364); !got.Equal(want) {
+		t.Errorf("Since() = %v, want %v", got, want)
+	}
+	if got, want := (HeatmapOptions{Year: 2024}).Since(), date("2024-01-01"); !got.Equal(want) {
+		t.Errorf("Since() for 2024 = %v, want %v", got, want)
+	}
+}
@@ -3,14 +3,16 @@ package ui
 import (
 	"fmt"
 	"math"
+	"strconv"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/fayssal-elmofatiche/gfetch/internal/git"
+	"github.com/fayssal-elmofatiche/gfetch/internal/i18n"
 )
 
 var (
-	labelStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#6CB6FF")).Width(14)
+	labelStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#6CB6FF"))
 	valueStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#E6EDF3"))
 	dimStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("#8B949E"))
 	titleStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#F0883E"))
@@ -21,8 +23,11 @@ type RenderParams struct {
 	Info             git.Info
 	Size             string
 	FileCount        int
+	SkippedFiles     int // binary or oversized source files left out of line counts
+	LFS              git.LFSStats
 	Languages        []git.LanguageStat
 	LOC              int
+	Lines            []git.LanguageLines
 	LastActivity     string
 	Velocity         git.Velocity
 	DepManager       string
@@ -35,6 +40,7 @@ type RenderParams struct {
 	Contributors     int
 	TestRatio        git.TestRatio
 	CommitConvention string
+	LanguageBar      bool // show a small language bar in the Languages row
 }
 
 func RenderLogo(language string) string {
@@ -42,11 +48,36 @@ func RenderLogo(language string) string {
 	return renderColoredArt(logo.art, logo.colors)
 }
 
-func row(label, value string) string {
+// infoLabels are the labels of the info panel rows, which share a column
+// as wide as the longest translation.
+var infoLabels = []string{
+	"Repository:", "Branch:", "Head:", "Author:", "Created:", "Last active:",
+	"Languages:", "Size:", "Lines:", "LFS:", "URL:", "Clone:", "Worktrees:",
+	"Authors:", "Version:", "License:", "Velocity:", "Deps:", "Branches:",
+	"CI/CD:", "Tests:", "Commits:", "Stash:", "Git:", "Status:",
+	"Packed:", "Loose:", "Maintenance:", "Largest blobs:",
+}
+
+// labelWidth returns the width of the label column in display cells, so
+// wide characters such as Japanese count twice.
+func labelWidth() int {
+	width := 14
+	for _, l := range infoLabels {
+		width = max(width, lipgloss.Width(i18n.T(l))+2)
+	}
+	return width
+}
+
+// label renders a translated info panel label, padded to the label column.
+func label(l string) string {
+	return labelStyle.Width(labelWidth()).Render(i18n.T(l))
+}
+
+func row(l, value string) string {
 	if value == "" {
 		value = "-"
 	}
-	return labelStyle.Render(label) + valueStyle.Render(value)
+	return label(l) + valueStyle.Render(value)
 }
 
 func formatLOC(loc int) string {
@@ -60,6 +91,26 @@ func formatLOC(loc int) string {
 	}
 }
 
+func formatFileCount(files, skipped int) string {
+	if skipped > 0 {
+		return fmt.Sprintf(i18n.T("(%d files, %d skipped)"), files, skipped)
+	}
+	return i18n.N(files, "(%d file)", "(%d files)")
+}
+
+// formatLines summarizes code lines, with comment and blank totals dimmed.
+func formatLines(loc int, lines []git.LanguageLines) string {
+	comments, blanks := 0, 0
+	for _, l := range lines {
+		comments += l.Comment
+		blanks += l.Blank
+	}
+	if comments == 0 && blanks == 0 {
+		return formatLOC(loc)
+	}
+	return fmt.Sprintf("%s %s", formatLOC(loc), dimStyle.Render(fmt.Sprintf(i18n.T("(%s comments, %s blank)"), formatLOC(comments), formatLOC(blanks))))
+}
+
 func RenderInfo(p RenderParams) string {
 	// Build language summary
 	var langParts []string
@@ -72,24 +123,56 @@ func RenderInfo(p RenderParams) string {
 	langSummary := strings.Join(langParts, ", ")
 	if langSummary == "" {
 		langSummary = "-"
+	} else if p.LanguageBar && !accessible {
+		langSummary = inlineLanguageBar(p.Languages, 12) + " " + langSummary
+	}
+
+	repoName := titleStyle.Render(p.Info.RepoName)
+	if p.Info.Bare {
+		repoName += " " + dimStyle.Render(i18n.T("(bare)"))
+	}
+
+	// Shallow clones only know part of the history
+	count, _ := strconv.Atoi(p.Info.CommitCount)
+	commits := i18n.N(count, "(%d commit)", "(%d commits)")
+	created := p.Info.Created
+	if p.Info.Shallow {
+		commits = i18n.N(count, "(shallow, %d commit available)", "(shallow, %d commits available)")
+		created = i18n.T("unknown") + " " + dimStyle.Render("("+i18n.T("shallow, history starts")+" "+p.Info.HistoryStart+")")
 	}
 
 	rows := []string{
-		row("Repository:", titleStyle.Render(p.Info.RepoName)),
-		row("Branch:", fmt.Sprintf("%s %s", p.Info.Branch, dimStyle.Render(fmt.Sprintf("(%s commits)", p.Info.CommitCount)))),
+		row("Repository:", repoName),
+		row("Branch:", fmt.Sprintf("%s %s", p.Info.Branch, dimStyle.Render(commits))),
 		row("Head:", fmt.Sprintf("%s %s", dimStyle.Render(p.Info.CommitHash), p.Info.LastCommitMessage)),
 		row("Author:", fmt.Sprintf("%s %s", p.Info.UserName, dimStyle.Render(fmt.Sprintf("<%s>", p.Info.UserEmail)))),
-		row("Created:", p.Info.Created),
+		row("Created:", created),
 		row("Last active:", p.LastActivity),
 		row("Languages:", langSummary),
-		row("Size:", fmt.Sprintf("%s %s", p.Size, dimStyle.Render(fmt.Sprintf("(%d files)", p.FileCount)))),
-		row("Lines:", formatLOC(p.LOC)),
+		row("Size:", fmt.Sprintf("%s %s", p.Size, dimStyle.Render(formatFileCount(p.FileCount, p.SkippedFiles)))),
+		row("Lines:", formatLines(p.LOC, p.Lines)),
+	}
+
+	if p.LFS.Files > 0 {
+		rows = append(rows, row("LFS:", i18n.N(p.LFS.Files, "%d file", "%d files")+", "+p.LFS.Size+" "+dimStyle.Render(fmt.Sprintf(i18n.T("(%d local)"), p.LFS.Local))))
 	}
 
 	if p.Info.RemoteURL != "" {
 		rows = append(rows, row("URL:", git.CleanURL(p.Info.RemoteURL)))
 	}
 
+	if p.Info.PartialFilter != "" {
+		rows = append(rows, row("Clone:", i18n.T("partial")+" "+dimStyle.Render("("+p.Info.PartialFilter+")")))
+	}
+
+	if p.Info.Worktrees > 1 {
+		worktrees := fmt.Sprintf("%d", p.Info.Worktrees)
+		if p.Info.LinkedWorktree {
+			worktrees += " " + dimStyle.Render(i18n.T("(this one linked)"))
+		}
+		rows = append(rows, row("Worktrees:", worktrees))
+	}
+
 	if p.Contributors > 0 {
 		rows = append(rows, row("Authors:", fmt.Sprintf("%d", p.Contributors)))
 	}
@@ -99,18 +182,23 @@ func RenderInfo(p RenderParams) string {
 	}
 
 	if p.License != "" {
-		rows = append(rows, row("License:", p.License))
+		rows = append(rows, row("License:", i18n.T(p.License)))
 	}
 
 	// Velocity
-	if p.Velocity.Sparkline != "" {
+	if len(p.Velocity.Weekly) > 0 {
 		trendStyle := dimStyle
-		if p.Velocity.Trend == "↑" {
+		if p.Velocity.Trend == git.TrendUp {
 			trendStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#3FB950"))
-		} else if p.Velocity.Trend == "↓" {
+		} else if p.Velocity.Trend == git.TrendDown {
 			trendStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#F85149"))
 		}
-		rows = append(rows, row("Velocity:", fmt.Sprintf("%.1f/wk %s %s", p.Velocity.PerWeek, p.Velocity.Sparkline, trendStyle.Render(p.Velocity.Trend))))
+		if accessible {
+			rows = append(rows, row("Velocity:", fmt.Sprintf(i18n.T("%.1f commits per week, %s (%s)"), p.Velocity.PerWeek, trendWord(p.Velocity.Trend), weeklyNumbers(p.Velocity.Weekly))))
+		} else {
+			spark := sparkline(p.Velocity.Weekly, peakOf(p.Velocity.Weekly))
+			rows = append(rows, row("Velocity:", fmt.Sprintf(i18n.T("%.1f/wk")+" %s %s", p.Velocity.PerWeek, spark, trendStyle.Render(trendGlyph(p.Velocity.Trend)))))
+		}
 	}
 
 	// Dependencies
@@ -123,10 +211,13 @@ func RenderInfo(p RenderParams) string {
 		branchStr := fmt.Sprintf("%d", p.Health.TotalBranches)
 		if p.Health.StaleBranches > 0 {
 			staleStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#F85149"))
-			branchStr += fmt.Sprintf(" %s", staleStyle.Render(fmt.Sprintf("(%d stale)", p.Health.StaleBranches)))
+			branchStr += fmt.Sprintf(" %s", staleStyle.Render(fmt.Sprintf(i18n.T("(%d stale)"), p.Health.StaleBranches)))
 		}
-		if p.Health.AheadBehind != "" {
-			branchStr += " " + dimStyle.Render(p.Health.AheadBehind)
+		switch {
+		case p.Health.DefaultBranch != "" && accessible:
+			branchStr += fmt.Sprintf(i18n.T(", %d ahead of and %d behind %s"), p.Health.Ahead, p.Health.Behind, p.Health.DefaultBranch)
+		case p.Health.DefaultBranch != "":
+			branchStr += " " + dimStyle.Render(fmt.Sprintf("%s%d %s%d %s %s", glyphs.Up, p.Health.Ahead, glyphs.Down, p.Health.Behind, i18n.T("vs"), p.Health.DefaultBranch))
 		}
 		rows = append(rows, row("Branches:", branchStr))
 	}
@@ -138,30 +229,33 @@ func RenderInfo(p RenderParams) string {
 
 	// Test ratio
 	if p.TestRatio.TestLines > 0 {
-		ratioStr := fmt.Sprintf("%.0f%% %s", p.TestRatio.Ratio*100, dimStyle.Render(fmt.Sprintf("(%s test / %s code)", formatLOC(p.TestRatio.TestLines), formatLOC(p.TestRatio.CodeLines))))
+		ratioStr := fmt.Sprintf("%.0f%% %s", p.TestRatio.Ratio*100, dimStyle.Render(fmt.Sprintf(i18n.T("(%s test / %s code)"), formatLOC(p.TestRatio.TestLines), formatLOC(p.TestRatio.CodeLines))))
 		rows = append(rows, row("Tests:", ratioStr))
 	}
 
 	// Commit convention
 	if p.CommitConvention != "" {
-		rows = append(rows, row("Commits:", p.CommitConvention))
+		rows = append(rows, row("Commits:", i18n.T(p.CommitConvention)))
 	}
 
 	// Stash
 	if p.StashCount > 0 {
 		stashStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#D2A8FF"))
-		rows = append(rows, row("Stash:", stashStyle.Render(fmt.Sprintf("%d entries", p.StashCount))))
+		rows = append(rows, row("Stash:", stashStyle.Render(i18n.N(p.StashCount, "%d entry", "%d entries"))))
 	}
 
 	if p.Info.GitVersion != "" {
 		rows = append(rows, row("Git:", p.Info.GitVersion))
 	}
 
-	statusStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#3FB950"))
-	if p.Info.Status != "clean" {
-		statusStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#F85149"))
+	// Bare repositories have no working tree to report on
+	if !p.Info.Bare {
+		statusStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#3FB950"))
+		if p.Info.Status != i18n.T("clean") {
+			statusStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#F85149"))
+		}
+		rows = append(rows, row("Status:", statusStyle.Render(p.Info.Status)))
 	}
-	rows = append(rows, row("Status:", statusStyle.Render(p.Info.Status)))
 
 	return lipgloss.JoinVertical(lipgloss.Left, rows...)
 }
@@ -171,6 +265,14 @@ func RenderLanguageBar(languages []git.LanguageStat, width int) string {
 		return ""
 	}
 
+	if accessible {
+		var parts []string
+		for _, lang := range languages {
+			parts = append(parts, fmt.Sprintf("%s %.1f%%", lang.Name, lang.Percentage))
+		}
+		return "\n" + titleStyle.Render(i18n.T("Languages")) + "\n  " + strings.Join(parts, ", ")
+	}
+
 	barWidth := width
 	if barWidth <= 0 {
 		barWidth = 50
@@ -191,7 +293,7 @@ func RenderLanguageBar(languages []git.LanguageStat, width int) string {
 			w = remaining
 		}
 		style := lipgloss.NewStyle().Foreground(lipgloss.Color(lang.Color))
-		bar.WriteString(style.Render(strings.Repeat("█", w)))
+		bar.WriteString(style.Render(strings.Repeat(fillGlyph(i), w)))
 		remaining -= w
 	}
 
@@ -204,13 +306,131 @@ func RenderLanguageBar(languages []git.LanguageStat, width int) string {
 		if i > 0 {
 			legend.WriteString("  ")
 		}
+		dot := glyphs.Dot
+		if !ColorEnabled() {
+			dot = fillGlyph(i)
+		}
 		dotStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(lang.Color))
-		legend.WriteString(fmt.Sprintf("%s %s %s", dotStyle.Render("●"), lang.Name, dimStyle.Render(fmt.Sprintf("%.1f%%", lang.Percentage))))
+		legend.WriteString(fmt.Sprintf("%s %s %s", dotStyle.Render(dot), lang.Name, dimStyle.Render(fmt.Sprintf("%.1f%%", lang.Percentage))))
 	}
 
 	return fmt.Sprintf("\n%s\n%s", bar.String(), legend.String())
 }
 
+// inlineLanguageBar draws the language breakdown as a bar of width cells
+// without a legend, to fit in an info row.
+func inlineLanguageBar(languages []git.LanguageStat, width int) string {
+	var bar strings.Builder
+	remaining := width
+	for i, lang := range languages {
+		w := int(math.Round(lang.Percentage / 100.0 * float64(width)))
+		if i == len(languages)-1 || w > remaining {
+			w = remaining
+		}
+		if w <= 0 {
+			continue
+		}
+		bar.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color(lang.Color)).Render(strings.Repeat(fillGlyph(i), w)))
+		remaining -= w
+	}
+	return bar.String()
+}
+
+// RenderOneline summarizes the repository on a single line, for shell
+// MOTDs and tmux status bars.
+func RenderOneline(p RenderParams) string {
+	sep := dimStyle.Render(glyphs.Sep)
+	if accessible {
+		sep = "; "
+	}
+	parts := []string{titleStyle.Render(p.Info.RepoName)}
+
+	branch := p.Info.Branch
+	switch {
+	case p.Info.Upstream != "" && accessible:
+		branch += fmt.Sprintf(i18n.T(", %d ahead, %d behind"), p.Info.Ahead, p.Info.Behind)
+	case p.Info.Upstream != "":
+		branch += fmt.Sprintf(" %s%d%s%d", glyphs.Up, p.Info.Ahead, glyphs.Down, p.Info.Behind)
+	}
+	parts = append(parts, branch)
+
+	if len(p.Languages) > 0 {
+		parts = append(parts, fmt.Sprintf("%s %.0f%%", p.Languages[0].Name, p.Languages[0].Percentage))
+	}
+	if p.LOC > 0 {
+		parts = append(parts, formatLOC(p.LOC)+" "+i18n.T("LOC"))
+	}
+	if len(p.Velocity.Weekly) > 0 && accessible {
+		parts = append(parts, fmt.Sprintf(i18n.T("%.1f commits per week, %s"), p.Velocity.PerWeek, trendWord(p.Velocity.Trend)))
+	} else if len(p.Velocity.Weekly) > 0 {
+		parts = append(parts, fmt.Sprintf(i18n.T("%.1f/wk")+" %s", p.Velocity.PerWeek, sparkline(p.Velocity.Weekly, peakOf(p.Velocity.Weekly))))
+	}
+	if !p.Info.Bare {
+		statusStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#3FB950"))
+		if p.Info.Status != i18n.T("clean") {
+			statusStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#F85149"))
+		}
+		parts = append(parts, statusStyle.Render(p.Info.Status))
+	}
+	return strings.Join(parts, sep)
+}
+
+// RenderLineCounts renders a per-language table of files, code, comment and
+// blank lines, like tokei or cloc.
+func RenderLineCounts(lines []git.LanguageLines) string {
+	if len(lines) == 0 {
+		return ""
+	}
+
+	// Translated headers can be wider than the numbers below them, and
+	// Japanese ones take two cells per character
+	columns := []string{i18n.T("Files"), i18n.T("Code"), i18n.T("Comment"), i18n.T("Blank")}
+	widths := []int{7, 9, 9, 9}
+	for i, c := range columns {
+		widths[i] = max(widths[i], lipgloss.Width(c))
+	}
+	nameWidth := max(lipgloss.Width(i18n.T("Language")), lipgloss.Width(i18n.T("Total")))
+	for _, l := range lines {
+		nameWidth = max(nameWidth, lipgloss.Width(l.Name))
+	}
+
+	header := titleStyle.Render(i18n.T("Lines of Code"))
+	var out []string
+	out = append(out, header)
+	head := "  " + padRight(i18n.T("Language"), nameWidth)
+	for i, c := range columns {
+		head += " " + padLeft(c, widths[i])
+	}
+	out = append(out, dimStyle.Render(head))
+
+	var total git.LanguageLines
+	for _, l := range lines {
+		out = append(out, fmt.Sprintf("  %s %*d %*d %s %s",
+			valueStyle.Render(padRight(l.Name, nameWidth)), widths[0], l.Files, widths[1], l.Code,
+			dimStyle.Render(fmt.Sprintf("%*d", widths[2], l.Comment)), dimStyle.Render(fmt.Sprintf("%*d", widths[3], l.Blank))))
+		total.Files += l.Files
+		total.Code += l.Code
+		total.Comment += l.Comment
+		total.Blank += l.Blank
+	}
+	if len(lines) > 1 {
+		out = append(out, fmt.Sprintf("  %s %*d %*d %s %s",
+			titleStyle.Render(padRight(i18n.T("Total"), nameWidth)), widths[0], total.Files, widths[1], total.Code,
+			dimStyle.Render(fmt.Sprintf("%*d", widths[2], total.Comment)), dimStyle.Render(fmt.Sprintf("%*d", widths[3], total.Blank))))
+	}
+	return "\n" + strings.Join(out, "\n")
+}
+
+// padRight pads s with spaces to width display cells.
+func padRight(s string, width int) string {
+	return s + strings.Repeat(" ", max(width-lipgloss.Width(s), 0))
+}
+
+// padLeft right-aligns s in width display cells.
+func padLeft(s string, width int) string {
+	return strings.Repeat(" ", max(width-lipgloss.Width(s), 0)) + s
+}
+
 func RenderContributors(stats git.ContributorStats) string {
 	if len(stats.Top) == 0 {
 		return ""
@@ -218,9 +438,9 @@ func RenderContributors(stats git.ContributorStats) string {
 
 	totalLabel := ""
 	if stats.Total > len(stats.Top) {
-		totalLabel = dimStyle.Render(fmt.Sprintf(" (%d total)", stats.Total))
+		totalLabel = dimStyle.Render(" " + fmt.Sprintf(i18n.T("(%d total)"), stats.Total))
 	}
-	header := titleStyle.Render("Top Authors") + totalLabel
+	header := titleStyle.Render(i18n.T("Top Authors")) + totalLabel
 	var lines []string
 	lines = append(lines, header)
 
@@ -239,10 +459,10 @@ func RenderContributors(stats git.ContributorStats) string {
 		if w < 1 {
 			w = 1
 		}
-		bar := barStyle.Render(strings.Repeat("█", w))
+		bar := renderBar(barStyle, glyphs.Block, w)
 		pct := float64(c.Commits) / float64(totalCommits) * 100
 		label := dimStyle.Render(fmt.Sprintf("%5.1f%%", pct))
-		lines = append(lines, fmt.Sprintf("  %s %s %s %s", label, bar, valueStyle.Render(c.Name), dimStyle.Render(fmt.Sprintf("(%d)", c.Commits))))
+		lines = append(lines, fmt.Sprintf("  %s %s%s %s", label, bar, valueStyle.Render(c.Name), dimStyle.Render(fmt.Sprintf("(%d)", c.Commits))))
 	}
 	return "\n" + strings.Join(lines, "\n")
 }
@@ -252,7 +472,7 @@ func RenderHotFiles(files []git.HotFile) string {
 		return ""
 	}
 
-	header := titleStyle.Render("Hot Files") + dimStyle.Render(" (90 days)")
+	header := titleStyle.Render(i18n.T("Hot Files")) + dimStyle.Render(" "+i18n.T("(90 days)"))
 	var lines []string
 	lines = append(lines, header)
 
@@ -265,9 +485,197 @@ func RenderHotFiles(files []git.HotFile) string {
 		if w < 1 {
 			w = 1
 		}
-		bar := barStyle.Render(strings.Repeat("█", w))
+		if accessible {
+			lines = append(lines, "  "+f.Path+", "+i18n.N(f.Changes, "%d change", "%d changes"))
+			continue
+		}
+		bar := renderBar(barStyle, glyphs.Block, w)
 		count := dimStyle.Render(fmt.Sprintf("%3d", f.Changes))
-		lines = append(lines, fmt.Sprintf("  %s %s %s", count, bar, valueStyle.Render(f.Path)))
+		lines = append(lines, fmt.Sprintf("  %s %s%s", count, bar, valueStyle.Render(f.Path)))
+	}
+	return "\n" + strings.Join(lines, "\n")
+}
+
+func RenderHotspots(hotspots []git.Hotspot) string {
+	if len(hotspots) == 0 {
+		return ""
+	}
+
+	times := glyphs.Times
+	if accessible {
+		times = i18n.T("times")
+	}
+	header := titleStyle.Render(i18n.T("Hotspots")) + dimStyle.Render(" ("+i18n.T("changes")+" "+times+" "+i18n.T("complexity")+")")
+	var lines []string
+	lines = append(lines, header)
+
+	maxScore := hotspots[0].Score
+	barMax := 20
+	barStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#F85149"))
+
+	for _, h := range hotspots {
+		w := int(float64(h.Score) / float64(maxScore) * float64(barMax))
+		if w < 1 {
+			w = 1
+		}
+		bar := renderBar(barStyle, glyphs.Block, w)
+		dims := dimStyle.Render(fmt.Sprintf(i18n.T("(%d changes, %s lines, complexity %s)"), h.Changes, formatLOC(h.LOC), formatLOC(h.Complexity)))
+		lines = append(lines, fmt.Sprintf("  %s%s %s", bar, valueStyle.Render(h.Path), dims))
+	}
+	return "\n" + strings.Join(lines, "\n")
+}
+
+func RenderCoupledFiles(pairs []git.CoupledFiles) string {
+	if len(pairs) == 0 {
+		return ""
+	}
+
+	header := titleStyle.Render(i18n.T("Coupled Files")) + dimStyle.Render(" "+i18n.T("(90 days)"))
+	var lines []string
+	lines = append(lines, header)
+
+	for _, p := range pairs {
+		if accessible {
+			lines = append(lines, fmt.Sprintf("  "+i18n.T("%s and %s change together: %d shared commits, %.0f%% confidence"), p.From, p.To, p.Support, p.Confidence*100))
+			continue
+		}
+		stats := dimStyle.Render(fmt.Sprintf("%3.0f%% %3d%s", p.Confidence*100, p.Support, glyphs.Times))
+		lines = append(lines, fmt.Sprintf("  %s %s %s %s", stats, valueStyle.Render(p.From), dimStyle.Render(glyphs.Right), valueStyle.Render(p.To)))
+	}
+	return "\n" + strings.Join(lines, "\n")
+}
+
+func RenderChurn(churn git.Churn) string {
+	if len(churn.Files) == 0 {
+		return ""
+	}
+
+	addStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#3FB950"))
+	delStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#F85149"))
+
+	header := titleStyle.Render(i18n.T("Churn")) + dimStyle.Render(" "+i18n.T("(90 days)"))
+	var lines []string
+	lines = append(lines, header)
+	// Scale both sparklines against the same peak so they're comparable
+	peak := peakOf(churn.AddedWeekly, churn.DeletedWeekly)
+	if accessible {
+		lines = append(lines, "  "+fmt.Sprintf(i18n.T("%s lines added (%s)"), formatLOC(churn.Added), weeklyNumbers(churn.AddedWeekly)),
+			"  "+fmt.Sprintf(i18n.T("%s lines deleted (%s)"), formatLOC(churn.Deleted), weeklyNumbers(churn.DeletedWeekly)))
+	} else {
+		lines = append(lines, fmt.Sprintf("  %s %s  %s %s",
+			addStyle.Render("+"+formatLOC(churn.Added)), addStyle.Render(sparkline(churn.AddedWeekly, peak)),
+			delStyle.Render("-"+formatLOC(churn.Deleted)), delStyle.Render(sparkline(churn.DeletedWeekly, peak))))
+	}
+
+	maxChurn := 0
+	for _, f := range churn.Files {
+		maxChurn = max(maxChurn, f.Added+f.Deleted)
+	}
+	barMax := 20
+
+	for _, f := range churn.Files {
+		total := f.Added + f.Deleted
+		w, addW := 1, 0
+		if maxChurn > 0 {
+			w = max(int(float64(total)/float64(maxChurn)*float64(barMax)), 1)
+		}
+		if total > 0 {
+			addW = int(math.Round(float64(f.Added) / float64(total) * float64(w)))
+		}
+		bar := ""
+		if !accessible {
+			bar = addStyle.Render(strings.Repeat(fillGlyph(0), addW)) + delStyle.Render(strings.Repeat(fillGlyph(len(glyphs.Fills)-1), w-addW)) + " "
+		}
+		count := dimStyle.Render(fmt.Sprintf("%6s", formatLOC(total)))
+		lines = append(lines, fmt.Sprintf("  %s %s%s %s", count, bar, valueStyle.Render(f.Path),
+			dimStyle.Render(fmt.Sprintf("(+%s -%s)", formatLOC(f.Added), formatLOC(f.Deleted)))))
+	}
+
+	if len(churn.Authors) > 0 {
+		var authors []string
+		for _, a := range churn.Authors {
+			authors = append(authors, fmt.Sprintf("%s %s", a.Name,
+				dimStyle.Render(fmt.Sprintf("+%s -%s", formatLOC(a.Added), formatLOC(a.Deleted)))))
+		}
+		lines = append(lines, "  "+dimStyle.Render(i18n.T("By author:")+" ")+strings.Join(authors, dimStyle.Render(", ")))
+	}
+	return "\n" + strings.Join(lines, "\n")
+}
+
+// formatGCReason describes why git gc is recommended, or is empty if it
+// isn't.
+func formatGCReason(r git.GCReason) string {
+	switch r.Kind {
+	case git.GCLooseObjects:
+		return i18n.N(r.Count, "%d loose object", "%d loose objects")
+	case git.GCPacks:
+		return i18n.N(r.Count, "%d pack", "%d packs")
+	case git.GCGarbage:
+		return i18n.N(r.Count, "%d garbage file", "%d garbage files")
+	}
+	return ""
+}
+
+func RenderStorage(st git.Storage) string {
+	header := titleStyle.Render(i18n.T("Storage"))
+	var lines []string
+	lines = append(lines, header)
+
+	packs := i18n.N(st.Packs, "in %d pack", "in %d packs")
+	lines = append(lines, "  "+row("Packed:", fmt.Sprintf("%s %s", st.PackSize, dimStyle.Render("("+i18n.N(st.PackedObjects, "%d object", "%d objects")+" "+packs+")"))))
+	lines = append(lines, "  "+row("Loose:", fmt.Sprintf("%s %s", st.LooseSize, dimStyle.Render("("+i18n.N(st.LooseObjects, "%d object", "%d objects")+")"))))
+
+	if reason := formatGCReason(st.GC); reason != "" {
+		warnStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#F85149"))
+		lines = append(lines, "  "+row("Maintenance:", warnStyle.Render(i18n.T("git gc recommended"))+" "+dimStyle.Render("("+reason+")")))
+	}
+
+	if len(st.LargestBlobs) > 0 {
+		lines = append(lines, "  "+label("Largest blobs:"))
+		deletedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#F85149"))
+		for _, b := range st.LargestBlobs {
+			line := fmt.Sprintf("    %s %s", dimStyle.Render(fmt.Sprintf("%9s", b.Size)), valueStyle.Render(b.Path))
+			if b.Deleted {
+				line += " " + deletedStyle.Render(i18n.T("(deleted)"))
+			}
+			lines = append(lines, line)
+		}
+	}
+	return "\n" + strings.Join(lines, "\n")
+}
+
+func RenderSubmodules(subs []git.Submodule) string {
+	if len(subs) == 0 {
+		return ""
+	}
+
+	header := titleStyle.Render(i18n.T("Submodules"))
+	var lines []string
+	lines = append(lines, header)
+
+	pathWidth := 0
+	for _, sm := range subs {
+		if len(sm.Path) > pathWidth {
+			pathWidth = len(sm.Path)
+		}
+	}
+
+	warnStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#F85149"))
+	for _, sm := range subs {
+		var state string
+		switch {
+		case !sm.Initialized:
+			state = dimStyle.Render(i18n.T("(not initialized)"))
+		case sm.Behind > 0:
+			state = warnStyle.Render(fmt.Sprintf(i18n.T("(%d behind %s)"), sm.Behind, sm.Branch))
+		case sm.Branch != "":
+			state = dimStyle.Render(fmt.Sprintf(i18n.T("(up to date with %s)"), sm.Branch))
+		}
+		line := fmt.Sprintf("  %s %s %s", valueStyle.Render(fmt.Sprintf("%-*s", pathWidth, sm.Path)), dimStyle.Render(sm.Commit), sm.URL)
+		if state != "" {
+			line += " " + state
+		}
+		lines = append(lines, line)
 	}
 	return "\n" + strings.Join(lines, "\n")
 }
@@ -277,7 +685,7 @@ func RenderReleases(releases []git.Release) string {
 		return ""
 	}
 
-	header := titleStyle.Render("Releases")
+	header := titleStyle.Render(i18n.T("Releases"))
 	var lines []string
 	lines = append(lines, header)
 
@@ -288,7 +696,43 @@ func RenderReleases(releases []git.Release) string {
 	return "\n" + strings.Join(lines, "\n")
 }
 
-func RenderLayout(logoBlock, info string) string {
+// renderBar draws a bar of w glyphs followed by a space. Accessible output
+// leaves bars out, since the numbers next to them say the same.
+func renderBar(style lipgloss.Style, glyph string, w int) string {
+	if accessible {
+		return ""
+	}
+	return style.Render(strings.Repeat(glyph, w)) + " "
+}
+
+// minValueWidth is how much of the info values must be visible next to the
+// logo; longer values, mostly the head commit subject, are cut off.
+const minValueWidth = 24
+
+// RenderLayout places the logo next to the info panel, or above it when
+// the logo leaves too little room for values, or drops the logo when even
+// that is too wide. Info lines longer than the space left are truncated.
+// A width of 0 means unlimited.
+func RenderLayout(logoBlock, info string, width int) string {
 	gap := "   "
-	return lipgloss.JoinHorizontal(lipgloss.Top, logoBlock, gap, info)
+	logoWidth := lipgloss.Width(logoBlock)
+	infoWidth := min(lipgloss.Width(info), labelWidth()+minValueWidth)
+	switch {
+	case width <= 0:
+		return lipgloss.JoinHorizontal(lipgloss.Top, logoBlock, gap, info)
+	case logoWidth+len(gap)+infoWidth <= width:
+		return lipgloss.JoinHorizontal(lipgloss.Top, logoBlock, gap, Fit(info, width-logoWidth-len(gap)))
+	case logoWidth <= width:
+		return lipgloss.JoinVertical(lipgloss.Left, logoBlock, "", Fit(info, width))
+	}
+	return Fit(info, width)
+}
+
+// Fit truncates each line of s to width columns, so that long values cut
+// off instead of wrapping into the next line. A width of 0 means unlimited.
+func Fit(s string, width int) string {
+	if width <= 0 {
+		return s
+	}
+	return lipgloss.NewStyle().MaxWidth(width).Render(s)
 }
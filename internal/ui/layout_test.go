@@ -0,0 +1,110 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/fayssal-elmofatiche/gfetch/internal/git"
+	"github.com/fayssal-elmofatiche/gfetch/internal/i18n"
+)
+
+func TestRenderChurn(t *testing.T) {
+	tests := []struct {
+		name  string
+		files []git.FileChurn
+		want  []string
+	}{
+		{
+			name:  "added and deleted",
+			files: []git.FileChurn{{Path: "a.go", Added: 30, Deleted: 10}, {Path: "b.go", Added: 0, Deleted: 4}},
+			want:  []string{"a.go (+30 -10)", "b.go (+0 -4)"},
+		},
+		{
+			name:  "rename only",
+			files: []git.FileChurn{{Path: "b.go"}},
+			want:  []string{"b.go (+0 -0)"},
+		},
+		{
+			name:  "zero next to nonzero",
+			files: []git.FileChurn{{Path: "a.go", Added: 5}, {Path: "b.go"}},
+			want:  []string{"a.go (+5 -0)", "b.go (+0 -0)"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := RenderChurn(git.Churn{Files: tt.files})
+			for _, w := range tt.want {
+				if !strings.Contains(out, w) {
+					t.Errorf("RenderChurn() missing %q in:\n%s", w, out)
+				}
+			}
+		})
+	}
+}
+
+func TestRenderChurnEmpty(t *testing.T) {
+	if out := RenderChurn(git.Churn{}); out != "" {
+		t.Errorf("RenderChurn(empty) = %q, want \"\"", out)
+	}
+}
+
+func TestRenderLayoutLongSubject(t *testing.T) {
+	info := RenderInfo(RenderParams{Info: git.Info{
+		RepoName:          "gfetch",
+		Branch:            "main",
+		CommitHash:        "abc1234",
+		LastCommitMessage: strings.Repeat("a long commit subject ", 10),
+	}})
+	tests := []struct {
+		name    string
+		logo    string
+		width   int
+		stacked bool
+	}{
+		{"logo beside info", RenderLogo("Go"), 100, false},
+		{"wide terminal", RenderLogo("Go"), 120, false},
+		{"small logo beside info", RenderSmallLogo("Go"), 80, false},
+		{"logo above info", RenderLogo("Go"), 60, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := RenderLayout(tt.logo, info, tt.width)
+			lines := strings.Split(out, "\n")
+			for _, line := range lines {
+				if w := lipgloss.Width(line); w > tt.width {
+					t.Errorf("line is %d wide, want at most %d: %q", w, tt.width, line)
+				}
+			}
+			first := strings.Split(tt.logo, "\n")[0]
+			if !strings.HasPrefix(lines[0], first) {
+				t.Fatalf("RenderLayout() dropped the logo:\n%s", out)
+			}
+			if beside := strings.Contains(lines[0], "gfetch"); beside == tt.stacked {
+				t.Errorf("RenderLayout() stacked = %v, want %v:\n%s", !beside, tt.stacked, out)
+			}
+		})
+	}
+}
+
+func TestFormatGCReason(t *testing.T) {
+	defer i18n.SetLocale("en")
+	tests := []struct {
+		locale string
+		reason git.GCReason
+		want   string
+	}{
+		{"en", git.GCReason{}, ""},
+		{"en", git.GCReason{Kind: git.GCLooseObjects, Count: 7000}, "7000 loose objects"},
+		{"en", git.GCReason{Kind: git.GCPacks, Count: 51}, "51 packs"},
+		{"en", git.GCReason{Kind: git.GCGarbage, Count: 1}, "1 garbage file"},
+		{"fr", git.GCReason{Kind: git.GCGarbage, Count: 2}, "2 fichiers parasites"},
+		{"de", git.GCReason{Kind: git.GCLooseObjects, Count: 1}, "1 loses Objekt"},
+	}
+	for _, tt := range tests {
+		i18n.SetLocale(tt.locale)
+		if got := formatGCReason(tt.reason); got != tt.want {
+			t.Errorf("%s: formatGCReason(%+v) = %q, want %q", tt.locale, tt.reason, got, tt.want)
+		}
+	}
+}
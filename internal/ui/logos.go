@@ -6,30 +6,75 @@ import (
 	"github.com/charmbracelet/lipgloss"
 )
 
-// Logo art adapted from onefetch (MIT License)
-// https://github.com/o2sh/onefetch
+// Logo art for the first fifteen languages is adapted from onefetch (MIT
+// License), https://github.com/o2sh/onefetch. The remaining emblems are drawn
+// in the same {N} color token format. Every logo also has a three-line small
+// variant for the compact layout.
 
 type logoData struct {
 	art    string
+	small  string
 	colors []string
 }
 
 var logos = map[string]logoData{
-	"Go":         goLogo,
-	"Python":     pythonLogo,
-	"JavaScript": javascriptLogo,
-	"TypeScript": typescriptLogo,
-	"Rust":       rustLogo,
-	"Java":       javaLogo,
-	"C":          cLogo,
-	"C++":        cppLogo,
-	"C#":         csharpLogo,
-	"Ruby":       rubyLogo,
-	"PHP":        phpLogo,
-	"Swift":      swiftLogo,
-	"Kotlin":     kotlinLogo,
-	"Shell":      shellLogo,
-	"HTML":       htmlLogo,
+	"Go":            goLogo,
+	"Python":        pythonLogo,
+	"JavaScript":    javascriptLogo,
+	"TypeScript":    typescriptLogo,
+	"Rust":          rustLogo,
+	"Java":          javaLogo,
+	"C":             cLogo,
+	"C++":           cppLogo,
+	"C#":            csharpLogo,
+	"Ruby":          rubyLogo,
+	"PHP":           phpLogo,
+	"Swift":         swiftLogo,
+	"Kotlin":        kotlinLogo,
+	"Shell":         shellLogo,
+	"HTML":          htmlLogo,
+	"CSS":           cssLogo,
+	"Lua":           luaLogo,
+	"Dart":          dartLogo,
+	"Zig":           zigLogo,
+	"Haskell":       haskellLogo,
+	"Elixir":        elixirLogo,
+	"Scala":         scalaLogo,
+	"Vue":           vueLogo,
+	"Svelte":        svelteLogo,
+	"Astro":         astroLogo,
+	"Terraform":     terraformLogo,
+	"HCL":           hclLogo,
+	"Protobuf":      protobufLogo,
+	"GraphQL":       graphqlLogo,
+	"Objective-C":   objectivecLogo,
+	"Objective-C++": objectivecppLogo,
+	"MATLAB":        matlabLogo,
+	"Perl":          perlLogo,
+	"Prolog":        prologLogo,
+	"R":             rLogo,
+	"Julia":         juliaLogo,
+	"OCaml":         ocamlLogo,
+	"F#":            fsharpLogo,
+	"Elm":           elmLogo,
+	"Clojure":       clojureLogo,
+	"Erlang":        erlangLogo,
+	"Nix":           nixLogo,
+	"Nim":           nimLogo,
+	"Crystal":       crystalLogo,
+	"Groovy":        groovyLogo,
+	"PowerShell":    powershellLogo,
+	"Batchfile":     batchfileLogo,
+	"SQL":           sqlLogo,
+	"Makefile":      makefileLogo,
+	"Dockerfile":    dockerfileLogo,
+	"CMake":         cmakeLogo,
+	"Starlark":      starlarkLogo,
+	"GLSL":          glslLogo,
+	"Assembly":      assemblyLogo,
+	"Fortran":       fortranLogo,
+	"Solidity":      solidityLogo,
+	"Visual Basic":  visualbasicLogo,
 }
 
 var goLogo = logoData{
@@ -58,6 +103,9 @@ var goLogo = logoData{
 {0}      -=oooooooooooooooooooooooo=.
 {2}     =oo{0}====oooooooooooooooo==-{2}oo=-
 {2}    .-==-    {0}.--=======---     {2}.==-`,
+	small: `{0} .-~~-.
+{0}( {1} GO {0} )
+{0} '-..-'`,
 }
 
 var pythonLogo = logoData{
@@ -84,6 +132,9 @@ var pythonLogo = logoData{
 {1}           =================
 {1}            ===============
 {1}               =========`,
+	small: `{0}.------.
+{0}| {1} PY {0} |
+{0}'------'`,
 }
 
 var javascriptLogo = logoData{
@@ -106,6 +157,9 @@ var javascriptLogo = logoData{
 {0}JSJSJSJSJSJ          SJSJSJ      SJSJS
 {0}JSJSJSJSJSJSJSJSJSJSJSJSJSJSJSJSJSJSJS
 {0}JSJSJSJSJSJSJSJSJSJSJSJSJSJSJSJSJSJSJS`,
+	small: `{0}.------.
+{0}| {1} JS {0} |
+{0}'------'`,
 }
 
 var typescriptLogo = logoData{
@@ -128,6 +182,9 @@ var typescriptLogo = logoData{
 {0}TSTSTSTSTSTST{1}STST{0}STSTSTSSTS{1}TSTSTS{0}TSTST
 {0}TSTSTSTSTSTSTSTSTSTSTSTSTSTSTSTSTSTSTS
 {0}TSTSTSTSTSTSTSTSTSTSTSTSTSTSTSTSTSTSTS`,
+	small: `{0}.------.
+{0}| {1} TS {0} |
+{0}'------'`,
 }
 
 var rustLogo = logoData{
@@ -148,6 +205,9 @@ var rustLogo = logoData{
 {0}       RR                        R
 {0}        R                       R
 {0}         R`,
+	small: `{0} .o==o.
+{0}o {1} RS {0} o
+{0} 'o==o'`,
 }
 
 var javaLogo = logoData{
@@ -174,6 +234,9 @@ var javaLogo = logoData{
 {1}   #####################      #
 {1}                          ###
 {1}          ###############`,
+	small: `{0}  ) )
+{0}[ {1}JAVA{0} ]
+{0} '----'`,
 }
 
 var cLogo = logoData{
@@ -201,6 +264,9 @@ var cLogo = logoData{
 {1}          ::::::::::::::::::::
 {1}              ::::::::::::
 {1}                 ::::::`,
+	small: `{0}  ____
+{0}/ {1} C  {0} \
+{0}\______/`,
 }
 
 var cppLogo = logoData{
@@ -228,6 +294,9 @@ var cppLogo = logoData{
 {1}          ::::::::::::::::::::
 {1}              ::::::::::::
 {1}                 ::::::`,
+	small: `{0}  ____
+{0}/ {1}C++ {0} \
+{0}\______/`,
 }
 
 var csharpLogo = logoData{
@@ -255,6 +324,9 @@ var csharpLogo = logoData{
 {1}          ::::::::::::::::::::
 {1}              ::::::::::::
 {1}                 ::::::`,
+	small: `{0}  ____
+{0}/ {1} C# {0} \
+{0}\______/`,
 }
 
 var rubyLogo = logoData{
@@ -276,6 +348,9 @@ var rubyLogo = logoData{
 {0}'/M:  md' /M/        -sNy:  -yNs. .Mo
 {0} 'Nh   Nh'Nh      :smd+.      .sNyoM/
 {0}   'h:mh:MmNss:+sdNds:-::///++oosyN-`,
+	small: `{0}  .''.
+{0}< {1} RB {0} >
+{0}  '..'`,
 }
 
 var phpLogo = logoData{
@@ -290,6 +365,9 @@ var phpLogo = logoData{
 {0}   ###{1}|  |{0}################{1}|  |{0}#######
 {1}      |_ /{0}################{1}|_ /{0}####
 {0}            ################`,
+	small: `{0} .-~~-.
+{0}( {1}PHP {0} )
+{0} '-..-'`,
 }
 
 var swiftLogo = logoData{
@@ -317,6 +395,9 @@ var swiftLogo = logoData{
 {8}        :::::::::::::::::::::::::::::::
 {8}          ::::::::::::::::::::::   :::::
 {9}             .::::::::::::::.         ::`,
+	small: `{0}.------.
+{0}| {1}SWFT{0} |
+{0}'------'`,
 }
 
 var kotlinLogo = logoData{
@@ -341,6 +422,9 @@ var kotlinLogo = logoData{
 {2}KOTLIN{0}KOTLINKOTLINK{2}OTLINKOTLINKOTLIN
 {2}KOTL{0}INKOTLINKOTLINKOT{2}LINKOTLINKOTLINKO
 {2}KO{0}TLINKOTLINKOTLINKOTLI{2}NKOTLINKOTLINKOTL`,
+	small: `{0}.------.
+{0}| {1} KT {0} |
+{0}'------'`,
 }
 
 var shellLogo = logoData{
@@ -362,6 +446,9 @@ var shellLogo = logoData{
 {0}    '-,_      |++++++_,-'
 {0}        '-,_  |++_,-'
 {0}            '-|-'`,
+	small: `{0}.------.
+{0}| {1} >_ {0} |
+{0}'------'`,
 }
 
 var htmlLogo = logoData{
@@ -387,6 +474,9 @@ var htmlLogo = logoData{
 {0}   ((((((((((((((/////////////((
 {0}   ((((((((((((((//////(((((((((
 {0}          (((((((((((((((`,
+	small: `{0}.------.
+{0}| {1} 5  {0} |
+{0} \____/`,
 }
 
 var defaultLogo = logoData{
@@ -396,6 +486,973 @@ var defaultLogo = logoData{
 {0} | |  _   | || | | | |_) |
 {0} | |_| |  | || |_| |  __/
 {0}  \____|  |_| \___/|_|`,
+	small: `{0}.------.
+{0}| {1}GIT {0} |
+{0}'------'`,
+}
+
+var cssLogo = logoData{
+	colors: []string{"#264DE4", "#2965F1"},
+	art: `{0}..................................
+{0}.{1}oooooooooooooooooooooooooooooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooooo{0}.
+{0} .{1}oooooooooooooooooooooooooooooo{0}.
+{0} .{1}oooooooooooooooooooooooooooooo{0}.
+{0} .{1}oooooooooooo      oooooooooooo{0}.
+{0} .{1}oooooooooooooooo  oooooooooooo{0}.
+{0} .{1}oooooooooooooo    oooooooooooo{0}.
+{0} .{1}oooooooooooooooo  oooooooooooo{0}.
+{0}  .{1}ooooooooooo      ooooooooooo{0}.
+{0}   ...{1}oooooooooooooooooooooo{0}...
+{0}      ..{1}oooooooooooooooooo{0}..
+{0}        ...{1}oooooooooooo{0}...
+{0}           ..{1}oooooooo{0}..
+{0}             ...{1}oo{0}...
+{0}                ..`,
+	small: `{0}.------.
+{0}| {1}CSS {0} |
+{0} \____/`,
+}
+
+var luaLogo = logoData{
+	colors: []string{"#000080", "#4B4BC8"},
+	art: `{0}            ............
+{0}        ....{1}oooooooooooo{0}....
+{0}     ...{1}oooooooooooooooooooo{0}...
+{0}   ..{1}oooooooooooooooooooooooooo{0}..
+{0}  .{1}oooooooooooooooooooooooooooooo{0}.
+{0} .{1}ooooo  oooooo  oo  oo      ooooo{0}.
+{0}.{1}oooooo  oooooo  oo  oo  oo  oooooo{0}.
+{0}.{1}oooooo  oooooo  oo  oo      oooooo{0}.
+{0}.{1}oooooo  oooooo  oo  oo  oo  oooooo{0}.
+{0}.{1}oooooo      oo      oo  oo  oooooo{0}.
+{0} .{1}oooooooooooooooooooooooooooooooo{0}.
+{0}  .{1}oooooooooooooooooooooooooooooo{0}.
+{0}   ..{1}oooooooooooooooooooooooooo{0}..
+{0}     ...{1}oooooooooooooooooooo{0}...
+{0}        ....{1}oooooooooooo{0}....
+{0}            ............`,
+	small: `{0} .-~~-.
+{0}( {1}LUA {0} )
+{0} '-..-'`,
+}
+
+var dartLogo = logoData{
+	colors: []string{"#0175C2", "#13B9FD"},
+	art: `{0}                ..
+{0}             ...{1}##{0}...
+{0}           ..{1}########{0}..
+{0}         ..{1}############{0}..
+{0}       ..{1}################{0}..
+{0}    ...{1}#######    #########{0}...
+{0}  ..{1}##########  ##  ##########{0}..
+{0}..{1}############  ##  ############{0}..
+{0}..{1}############  ##  ############{0}..
+{0}  ..{1}##########    ############{0}..
+{0}    ...{1}####################{0}...
+{0}       ..{1}################{0}..
+{0}         ..{1}############{0}..
+{0}           ..{1}########{0}..
+{0}             ...{1}##{0}...
+{0}                ..`,
+	small: `{0}  .''.
+{0}< {1}DART{0} >
+{0}  '..'`,
+}
+
+var zigLogo = logoData{
+	colors: []string{"#F7A41D", "#F7A41D"},
+	art: `{0}              ....
+{0}          ....{1}===={0}....
+{0}      ....{1}============{0}....
+{0}  ....{1}===================={0}....
+{0}..{1}============================{0}..
+{0}.{1}====      ==      ==      ===={0}.
+{0}.{1}========  ====  ====  ========{0}.
+{0}.{1}======  ======  ====  ==  ===={0}.
+{0}.{1}====  ========  ====  ==  ===={0}.
+{0}.{1}====      ==      ==      ===={0}.
+{0}.{1}=============================={0}.
+{0}..{1}============================{0}..
+{0}  ....{1}===================={0}....
+{0}      ....{1}============{0}....
+{0}          ....{1}===={0}....
+{0}              ....`,
+	small: `{0}  .--.
+{0}( {1}ZIG {0} )
+{0}  '--'`,
+}
+
+var haskellLogo = logoData{
+	colors: []string{"#453A62", "#5E5086"},
+	art: `{0}                ..
+{0}             ...{1}=={0}...
+{0}           ..{1}========{0}..
+{0}         ..{1}============{0}..
+{0}       ..{1}================{0}..
+{0}    ...{1}=======  ==========={0}...
+{0}  ..{1}============  ============{0}..
+{0}..{1}==============  =============={0}..
+{0}..{1}============  ==  ============{0}..
+{0}  ..{1}==========  ==  =========={0}..
+{0}    ...{1}===================={0}...
+{0}       ..{1}================{0}..
+{0}         ..{1}============{0}..
+{0}           ..{1}========{0}..
+{0}             ...{1}=={0}...
+{0}                ..`,
+	small: `{0}  .''.
+{0}< {1}>>= {0} >
+{0}  '..'`,
+}
+
+var elixirLogo = logoData{
+	colors: []string{"#4B275F", "#6E4A7E"},
+	art: `{0}              ..
+{0}             .{1}oo{0}.
+{0}           ..{1}oooo{0}..
+{0}         ..{1}oooooooo{0}..
+{0}        .{1}oooooooooooo{0}.
+{0}      ..{1}oooooooooooooo{0}..
+{0}     .{1}oooooooooooooooooo{0}.
+{0}   ..{1}ooo      oo  oo  ooo{0}..
+{0} ..{1}ooooo  oooooo  oo  ooooo{0}..
+{0}.{1}ooooooo    oooooo  ooooooooo{0}.
+{0}.{1}ooooooo  oooooo  oo  ooooooo{0}.
+{0}.{1}ooooooo      oo  oo  ooooooo{0}.
+{0} ..{1}oooooooooooooooooooooooo{0}..
+{0}   ..{1}oooooooooooooooooooo{0}..
+{0}     ....{1}oooooooooooo{0}....
+{0}         ............`,
+	small: `{0}   /\
+{0}/ {1} EX {0} \
+{0}\______/`,
+}
+
+var scalaLogo = logoData{
+	colors: []string{"#B5121B", "#DC322F"},
+	art: `{0}  ................................
+{0}..{1}================================{0}..
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}.{1}==============      =============={0}.
+{0}.{1}==============  =================={0}.
+{0}.{1}==============      =============={0}.
+{0}.{1}==================  =============={0}.
+{0}.{1}==============      =============={0}.
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}..{1}================================{0}..
+{0}  ................................`,
+	small: `{0}.------.
+{0}| {1} SC {0} |
+{0}'------'`,
+}
+
+var vueLogo = logoData{
+	colors: []string{"#35495E", "#41B883"},
+	art: `{0}....................................
+{0} ..{1}##############################{0}..
+{0}   .{1}###########  ##  ###########{0}.
+{0}    .{1}##########  ##  ##########{0}.
+{0}     .{1}#########  ##  #########{0}.
+{0}      .{1}########  ##  ########{0}.
+{0}       .{1}#########  #########{0}.
+{0}        .{1}##################{0}.
+{0}         ..{1}##############{0}..
+{0}           .{1}############{0}.
+{0}            .{1}##########{0}.
+{0}             .{1}########{0}.
+{0}              .{1}######{0}.
+{0}               .{1}####{0}.
+{0}                .{1}##{0}.
+{0}                 ..`,
+	small: `{0}\''''''/
+{0} \{1}VUE {0}/
+{0}  \__/`,
+}
+
+var svelteLogo = logoData{
+	colors: []string{"#E03A00", "#FF3E00"},
+	art: `{0}  ................................
+{0}..{1}oooooooooooooooooooooooooooooooo{0}..
+{0}.{1}oooooooooooooooooooooooooooooooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooooooo{0}.
+{0}.{1}oooooooooooooo      oooooooooooooo{0}.
+{0}.{1}oooooooooooooo  oooooooooooooooooo{0}.
+{0}.{1}oooooooooooooo      oooooooooooooo{0}.
+{0}.{1}oooooooooooooooooo  oooooooooooooo{0}.
+{0}.{1}oooooooooooooo      oooooooooooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooooooo{0}.
+{0}..{1}oooooooooooooooooooooooooooooooo{0}..
+{0}  ................................`,
+	small: `{0}.------.
+{0}| {1} SV {0} |
+{0}'------'`,
+}
+
+var astroLogo = logoData{
+	colors: []string{"#BC52EE", "#FF5A03"},
+	art: `{0}                 ..
+{0}                .{1}=={0}.
+{0}               .{1}===={0}.
+{0}              .{1}======{0}.
+{0}             .{1}========{0}.
+{0}            .{1}=========={0}.
+{0}           .{1}============{0}.
+{0}         ..{1}=============={0}..
+{0}        .{1}======      ======{0}.
+{0}       .{1}=======  ==  ======={0}.
+{0}      .{1}========      ========{0}.
+{0}     .{1}=========  ==  ========={0}.
+{0}    .{1}==========  ==  =========={0}.
+{0}   .{1}============================{0}.
+{0} ..{1}=============================={0}..
+{0}....................................`,
+	small: `{0}   /\
+{0} /{1}ASTR{0}\
+{0}/______\`,
+}
+
+var terraformLogo = logoData{
+	colors: []string{"#5C4EE5", "#7B42BC"},
+	art: `{0}              ....
+{0}          ....{1}####{0}....
+{0}      ....{1}############{0}....
+{0}  ....{1}####################{0}....
+{0}..{1}############################{0}..
+{0}.{1}########      ##      ########{0}.
+{0}.{1}##########  ####  ############{0}.
+{0}.{1}##########  ####    ##########{0}.
+{0}.{1}##########  ####  ############{0}.
+{0}.{1}##########  ####  ############{0}.
+{0}.{1}##############################{0}.
+{0}..{1}############################{0}..
+{0}  ....{1}####################{0}....
+{0}      ....{1}############{0}....
+{0}          ....{1}####{0}....
+{0}              ....`,
+	small: `{0}  .--.
+{0}( {1} TF {0} )
+{0}  '--'`,
+}
+
+var hclLogo = logoData{
+	colors: []string{"#5C4EE5", "#844FBA"},
+	art: `{0}              ....
+{0}          ....{1}oooo{0}....
+{0}      ....{1}oooooooooooo{0}....
+{0}  ....{1}oooooooooooooooooooo{0}....
+{0}..{1}oooooooooooooooooooooooooooo{0}..
+{0}.{1}oooo  oo  oo      oo  oooooooo{0}.
+{0}.{1}oooo  oo  oo  oooooo  oooooooo{0}.
+{0}.{1}oooo      oo  oooooo  oooooooo{0}.
+{0}.{1}oooo  oo  oo  oooooo  oooooooo{0}.
+{0}.{1}oooo  oo  oo      oo      oooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooo{0}.
+{0}..{1}oooooooooooooooooooooooooooo{0}..
+{0}  ....{1}oooooooooooooooooooo{0}....
+{0}      ....{1}oooooooooooo{0}....
+{0}          ....{1}oooo{0}....
+{0}              ....`,
+	small: `{0}  .--.
+{0}( {1}HCL {0} )
+{0}  '--'`,
+}
+
+var protobufLogo = logoData{
+	colors: []string{"#2A6EDB", "#4285F4"},
+	art: `{0}  ................................
+{0}..{1}================================{0}..
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}.{1}==========      ==    ============{0}.
+{0}.{1}==========  ==  ==  ==  =========={0}.
+{0}.{1}==========      ==    ============{0}.
+{0}.{1}==========  ======  ==  =========={0}.
+{0}.{1}==========  ======    ============{0}.
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}..{1}================================{0}..
+{0}  ................................`,
+	small: `{0}.------.
+{0}| {1} PB {0} |
+{0}'------'`,
+}
+
+var graphqlLogo = logoData{
+	colors: []string{"#B8007E", "#E10098"},
+	art: `{0}       ....................
+{0}      .{1}oooooooooooooooooooo{0}.
+{0}     .{1}oooooooooooooooooooooo{0}.
+{0}    .{1}oooooooooooooooooooooooo{0}.
+{0}   .{1}oooooooooooooooooooooooooo{0}.
+{0}  .{1}ooo      oo      oo  ooooooo{0}.
+{0} .{1}oooo  oooooo  oo  oo  oooooooo{0}.
+{0}.{1}ooooo  oo  oo  oo  oo  ooooooooo{0}.
+{0}.{1}ooooo  oo  oo      oo  ooooooooo{0}.
+{0} .{1}oooo      oooooo  oo      oooo{0}.
+{0}  .{1}oooooooooooooooooooooooooooo{0}.
+{0}   .{1}oooooooooooooooooooooooooo{0}.
+{0}    .{1}oooooooooooooooooooooooo{0}.
+{0}     .{1}oooooooooooooooooooooo{0}.
+{0}      .{1}oooooooooooooooooooo{0}.
+{0}       ....................`,
+	small: `{0}  ____
+{0}/ {1}GQL {0} \
+{0}\______/`,
+}
+
+var objectivecLogo = logoData{
+	colors: []string{"#2E6CCF", "#438EFF"},
+	art: `{0}            ............
+{0}        ....{1}oooooooooooo{0}....
+{0}     ...{1}oooooooooooooooooooo{0}...
+{0}   ..{1}oooooooooooooooooooooooooo{0}..
+{0}  .{1}oooooooooooooooooooooooooooooo{0}.
+{0} .{1}ooooo      oo    oooooooo  ooooo{0}.
+{0}.{1}oooooo  oo  oo  oo  oooooo  oooooo{0}.
+{0}.{1}oooooo  oo  oo    oooooooo  oooooo{0}.
+{0}.{1}oooooo  oo  oo  oo  oo  oo  oooooo{0}.
+{0}.{1}oooooo      oo    oooo      oooooo{0}.
+{0} .{1}oooooooooooooooooooooooooooooooo{0}.
+{0}  .{1}oooooooooooooooooooooooooooooo{0}.
+{0}   ..{1}oooooooooooooooooooooooooo{0}..
+{0}     ...{1}oooooooooooooooooooo{0}...
+{0}        ....{1}oooooooooooo{0}....
+{0}            ............`,
+	small: `{0} .-~~-.
+{0}( {1}OBJC{0} )
+{0} '-..-'`,
+}
+
+var objectivecppLogo = logoData{
+	colors: []string{"#4F4DD9", "#6866FB"},
+	art: `{0}            ............
+{0}        ....{1}oooooooooooo{0}....
+{0}     ...{1}oooooooooooooooooooo{0}...
+{0}   ..{1}oooooooooooooooooooooooooo{0}..
+{0}  .{1}oooooooooooooooooooooooooooooo{0}.
+{0} .{1}ooooo      oo      ooooooooooooo{0}.
+{0}.{1}oooooo  oo  oo  oooooooo  oooooooo{0}.
+{0}.{1}oooooo  oo  oo  oooooo      oooooo{0}.
+{0}.{1}oooooo  oo  oo  oooooooo  oooooooo{0}.
+{0}.{1}oooooo      oo      oooooooooooooo{0}.
+{0} .{1}oooooooooooooooooooooooooooooooo{0}.
+{0}  .{1}oooooooooooooooooooooooooooooo{0}.
+{0}   ..{1}oooooooooooooooooooooooooo{0}..
+{0}     ...{1}oooooooooooooooooooo{0}...
+{0}        ....{1}oooooooooooo{0}....
+{0}            ............`,
+	small: `{0} .-~~-.
+{0}( {1}OC++{0} )
+{0} '-..-'`,
+}
+
+var matlabLogo = logoData{
+	colors: []string{"#C2410C", "#E16737"},
+	art: `{0}                ..
+{0}             ...{1}##{0}...
+{0}           ..{1}########{0}..
+{0}         ..{1}############{0}..
+{0}       ..{1}################{0}..
+{0}    ...{1}#######  ##  #######{0}...
+{0}  ..{1}##########      ##########{0}..
+{0}..{1}############      ############{0}..
+{0}..{1}############  ##  ############{0}..
+{0}  ..{1}##########  ##  ##########{0}..
+{0}    ...{1}####################{0}...
+{0}       ..{1}################{0}..
+{0}         ..{1}############{0}..
+{0}           ..{1}########{0}..
+{0}             ...{1}##{0}...
+{0}                ..`,
+	small: `{0}  .''.
+{0}< {1}MAT {0} >
+{0}  '..'`,
+}
+
+var perlLogo = logoData{
+	colors: []string{"#0073A1", "#39457E"},
+	art: `{0}            ............
+{0}        ....{1}oooooooooooo{0}....
+{0}     ...{1}oooooooooooooooooooo{0}...
+{0}   ..{1}oooooooooooooooooooooooooo{0}..
+{0}  .{1}oooooooooooooooooooooooooooooo{0}.
+{0} .{1}ooooooooo      oo  ooooooooooooo{0}.
+{0}.{1}oooooooooo  oo  oo  oooooooooooooo{0}.
+{0}.{1}oooooooooo      oo  oooooooooooooo{0}.
+{0}.{1}oooooooooo  oooooo  oooooooooooooo{0}.
+{0}.{1}oooooooooo  oooooo      oooooooooo{0}.
+{0} .{1}oooooooooooooooooooooooooooooooo{0}.
+{0}  .{1}oooooooooooooooooooooooooooooo{0}.
+{0}   ..{1}oooooooooooooooooooooooooo{0}..
+{0}     ...{1}oooooooooooooooooooo{0}...
+{0}        ....{1}oooooooooooo{0}....
+{0}            ............`,
+	small: `{0} .-~~-.
+{0}( {1}PERL{0} )
+{0} '-..-'`,
+}
+
+var prologLogo = logoData{
+	colors: []string{"#74283C", "#B0344F"},
+	art: `{0}  ................................
+{0}..{1}################################{0}..
+{0}.{1}##################################{0}.
+{0}.{1}##################################{0}.
+{0}.{1}##################################{0}.
+{0}.{1}##########      ##################{0}.
+{0}.{1}##############  ##################{0}.
+{0}.{1}############    ##      ##########{0}.
+{0}.{1}##################################{0}.
+{0}.{1}############  ####################{0}.
+{0}.{1}##################################{0}.
+{0}.{1}##################################{0}.
+{0}.{1}##################################{0}.
+{0}.{1}##################################{0}.
+{0}..{1}################################{0}..
+{0}  ................................`,
+	small: `{0}.------.
+{0}| {1} ?- {0} |
+{0}'------'`,
+}
+
+var rLogo = logoData{
+	colors: []string{"#8A8A8A", "#198CE7"},
+	art: `{0}            ............
+{0}        ....{1}oooooooooooo{0}....
+{0}     ...{1}oooooooooooooooooooo{0}...
+{0}   ..{1}oooooooooooooooooooooooooo{0}..
+{0}  .{1}oooooooooooooooooooooooooooooo{0}.
+{0} .{1}ooooooooooooo    ooooooooooooooo{0}.
+{0}.{1}oooooooooooooo  oo  oooooooooooooo{0}.
+{0}.{1}oooooooooooooo    oooooooooooooooo{0}.
+{0}.{1}oooooooooooooo  oo  oooooooooooooo{0}.
+{0}.{1}oooooooooooooo  oo  oooooooooooooo{0}.
+{0} .{1}oooooooooooooooooooooooooooooooo{0}.
+{0}  .{1}oooooooooooooooooooooooooooooo{0}.
+{0}   ..{1}oooooooooooooooooooooooooo{0}..
+{0}     ...{1}oooooooooooooooooooo{0}...
+{0}        ....{1}oooooooooooo{0}....
+{0}            ............`,
+	small: `{0} .-~~-.
+{0}( {1} R  {0} )
+{0} '-..-'`,
+}
+
+var juliaLogo = logoData{
+	colors: []string{"#9558B2", "#CB3C33"},
+	art: `{0}            ............
+{0}        ....{1}oooooooooooo{0}....
+{0}     ...{1}oooooooooooooooooooo{0}...
+{0}   ..{1}oooooooooooooooooooooooooo{0}..
+{0}  .{1}oooooooooooooooooooooooooooooo{0}.
+{0} .{1}ooooooooooooo  oo  ooooooooooooo{0}.
+{0}.{1}oooooooooooooo  oo  oooooooooooooo{0}.
+{0}.{1}oooooooooooooo  oo  oooooooooooooo{0}.
+{0}.{1}oooooooooo  oo  oo  oooooooooooooo{0}.
+{0}.{1}oooooooooo      oo      oooooooooo{0}.
+{0} .{1}oooooooooooooooooooooooooooooooo{0}.
+{0}  .{1}oooooooooooooooooooooooooooooo{0}.
+{0}   ..{1}oooooooooooooooooooooooooo{0}..
+{0}     ...{1}oooooooooooooooooooo{0}...
+{0}        ....{1}oooooooooooo{0}....
+{0}            ............`,
+	small: `{0} .-~~-.
+{0}( {1} JL {0} )
+{0} '-..-'`,
+}
+
+var ocamlLogo = logoData{
+	colors: []string{"#C4561A", "#EE6A1A"},
+	art: `{0}  ................................
+{0}..{1}================================{0}..
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}.{1}==========  ==  ==  =============={0}.
+{0}.{1}==========      ==  =============={0}.
+{0}.{1}==========      ==  =============={0}.
+{0}.{1}==========  ==  ==  =============={0}.
+{0}.{1}==========  ==  ==      =========={0}.
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}..{1}================================{0}..
+{0}  ................................`,
+	small: `{0}.------.
+{0}| {1} ML {0} |
+{0}'------'`,
+}
+
+var fsharpLogo = logoData{
+	colors: []string{"#378BBA", "#B845FC"},
+	art: `{0}                ..
+{0}             ...{1}##{0}...
+{0}           ..{1}########{0}..
+{0}         ..{1}############{0}..
+{0}       ..{1}################{0}..
+{0}    ...{1}###      ##  ##  ###{0}...
+{0}  ..{1}######  ######      ######{0}..
+{0}..{1}########    ####  ##  ########{0}..
+{0}..{1}########  ######      ########{0}..
+{0}  ..{1}######  ######  ##  ######{0}..
+{0}    ...{1}####################{0}...
+{0}       ..{1}################{0}..
+{0}         ..{1}############{0}..
+{0}           ..{1}########{0}..
+{0}             ...{1}##{0}...
+{0}                ..`,
+	small: `{0}  .''.
+{0}< {1} F# {0} >
+{0}  '..'`,
+}
+
+var elmLogo = logoData{
+	colors: []string{"#5A6378", "#60B5CC"},
+	art: `{0}                ..
+{0}             ...{1}=={0}...
+{0}           ..{1}========{0}..
+{0}         ..{1}============{0}..
+{0}       ..{1}================{0}..
+{0}    ..      {1}==  ======  ==  {0}..
+{0}  ..{1}==  ======  ======      =={0}..
+{0}..{1}====    ====  ======      ===={0}..
+{0}..{1}====  ======  ======  ==  ===={0}..
+{0}  ..{1}==      ==      ==  ==  =={0}..
+{0}    ...{1}===================={0}...
+{0}       ..{1}================{0}..
+{0}         ..{1}============{0}..
+{0}           ..{1}========{0}..
+{0}             ...{1}=={0}...
+{0}                ..`,
+	small: `{0}  .''.
+{0}< {1}ELM {0} >
+{0}  '..'`,
+}
+
+var clojureLogo = logoData{
+	colors: []string{"#63B132", "#5881D8"},
+	art: `{0}            ............
+{0}        ....{1}oooooooooooo{0}....
+{0}     ...{1}oooooooooooooooooooo{0}...
+{0}   ..{1}oooooooooooooooooooooooooo{0}..
+{0}  .{1}oooooooooooooooooooooooooooooo{0}.
+{0} .{1}ooooooooooooo  ooooooooooooooooo{0}.
+{0}.{1}oooooooooooooooo  oooooooooooooooo{0}.
+{0}.{1}oooooooooooooooo  oooooooooooooooo{0}.
+{0}.{1}oooooooooooooo  oo  oooooooooooooo{0}.
+{0}.{1}oooooooooooooo  oo  oooooooooooooo{0}.
+{0} .{1}oooooooooooooooooooooooooooooooo{0}.
+{0}  .{1}oooooooooooooooooooooooooooooo{0}.
+{0}   ..{1}oooooooooooooooooooooooooo{0}..
+{0}     ...{1}oooooooooooooooooooo{0}...
+{0}        ....{1}oooooooooooo{0}....
+{0}            ............`,
+	small: `{0} .-~~-.
+{0}( {1}CLJ {0} )
+{0} '-..-'`,
+}
+
+var erlangLogo = logoData{
+	colors: []string{"#A90533", "#D6194D"},
+	art: `{0}  ................................
+{0}..{1}oooooooooooooooooooooooooooooooo{0}..
+{0}.{1}oooooooooooooooooooooooooooooooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooooooo{0}.
+{0}.{1}oooooooooooooo      oooooooooooooo{0}.
+{0}.{1}oooooooooooooo  oooooooooooooooooo{0}.
+{0}.{1}oooooooooooooo    oooooooooooooooo{0}.
+{0}.{1}oooooooooooooo  oooooooooooooooooo{0}.
+{0}.{1}oooooooooooooo      oooooooooooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooooooo{0}.
+{0}..{1}oooooooooooooooooooooooooooooooo{0}..
+{0}  ................................`,
+	small: `{0}.------.
+{0}| {1}ERL {0} |
+{0}'------'`,
+}
+
+var nixLogo = logoData{
+	colors: []string{"#5277C3", "#7EBAE4"},
+	art: `{0}       ....................
+{0}      .{1}####################{0}.
+{0}     .{1}######################{0}.
+{0}    .{1}########################{0}.
+{0}   .{1}##########################{0}.
+{0}  .{1}###    ####      ##  ##  ###{0}.
+{0} .{1}####  ##  ####  ####  ##  ####{0}.
+{0}.{1}#####  ##  ####  ######  #######{0}.
+{0}.{1}#####  ##  ####  ####  ##  #####{0}.
+{0} .{1}####  ##  ##      ##  ##  ####{0}.
+{0}  .{1}############################{0}.
+{0}   .{1}##########################{0}.
+{0}    .{1}########################{0}.
+{0}     .{1}######################{0}.
+{0}      .{1}####################{0}.
+{0}       ....................`,
+	small: `{0}  ____
+{0}/ {1}NIX {0} \
+{0}\______/`,
+}
+
+var nimLogo = logoData{
+	colors: []string{"#C5A300", "#FFE953"},
+	art: `{0}  .            ..            .
+{0} .{1}o{0}.           ..           .{1}o{0}.
+{0} .{1}o{0}.          .{1}oo{0}.          .{1}o{0}.
+{0}.{1}ooo{0}.         .{1}oo{0}.         .{1}ooo{0}.
+{0}.{1}ooo{0}.        .{1}oooo{0}.        .{1}ooo{0}.
+{0}.{1}oooo{0}.       .{1}oooo{0}.       .{1}oooo{0}.
+{0}.{1}oooo{0}.      .{1}oooooo{0}.      .{1}oooo{0}.
+{0}.{1}oooo{0}.      .{1}oooooo{0}.      .{1}oooo{0}.
+{0}.{1}ooooo{0}.     .{1}oooooo{0}.     .{1}ooooo{0}.
+{0}.{1}ooooo{0}.    .{1}oooooooo{0}.    .{1}ooooo{0}.
+{0}.{1}oooooo{0}....{1}oooooooooo{0}....{1}oooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooo{0}.
+{0}................................`,
+	small: `{0} /\/\/\
+{0}| {1}NIM {0} |
+{0}'------'`,
+}
+
+var crystalLogo = logoData{
+	colors: []string{"#8A8A8A", "#D0D0D0"},
+	art: `{0}                ..
+{0}             ...{1}##{0}...
+{0}           ..{1}########{0}..
+{0}         ..{1}############{0}..
+{0}       ..{1}################{0}..
+{0}    ...{1}###      ##    #####{0}...
+{0}  ..{1}######  ######  ##  ######{0}..
+{0}..{1}########  ######    ##########{0}..
+{0}..{1}########  ######  ##  ########{0}..
+{0}  ..{1}######      ##  ##  ######{0}..
+{0}    ...{1}####################{0}...
+{0}       ..{1}################{0}..
+{0}         ..{1}############{0}..
+{0}           ..{1}########{0}..
+{0}             ...{1}##{0}...
+{0}                ..`,
+	small: `{0}  .''.
+{0}< {1} CR {0} >
+{0}  '..'`,
+}
+
+var groovyLogo = logoData{
+	colors: []string{"#336A7D", "#4298B8"},
+	art: `{0}            ............
+{0}        ....{1}oooooooooooo{0}....
+{0}     ...{1}oooooooooooooooooooo{0}...
+{0}   ..{1}oooooooooooooooooooooooooo{0}..
+{0}  .{1}oooooooooooooooooooooooooooooo{0}.
+{0} .{1}ooooooooooooo      ooooooooooooo{0}.
+{0}.{1}oooooooooooooo  oooooooooooooooooo{0}.
+{0}.{1}oooooooooooooo  oo  oooooooooooooo{0}.
+{0}.{1}oooooooooooooo  oo  oooooooooooooo{0}.
+{0}.{1}oooooooooooooo      oooooooooooooo{0}.
+{0} .{1}oooooooooooooooooooooooooooooooo{0}.
+{0}  .{1}oooooooooooooooooooooooooooooo{0}.
+{0}   ..{1}oooooooooooooooooooooooooo{0}..
+{0}     ...{1}oooooooooooooooooooo{0}...
+{0}        ....{1}oooooooooooo{0}....
+{0}            ............`,
+	small: `{0} .-~~-.
+{0}( {1}GRV {0} )
+{0} '-..-'`,
+}
+
+var powershellLogo = logoData{
+	colors: []string{"#2C5591", "#5391FE"},
+	art: `{0}  ................................
+{0}..{1}================================{0}..
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}.{1}==========  ======================{0}.
+{0}.{1}============  ===================={0}.
+{0}.{1}==============  =================={0}.
+{0}.{1}============  ===================={0}.
+{0}.{1}==========  ======      =========={0}.
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}..{1}================================{0}..
+{0}  ................................`,
+	small: `{0}.------.
+{0}| {1} >_ {0} |
+{0}'------'`,
+}
+
+var batchfileLogo = logoData{
+	colors: []string{"#8FB31A", "#C1F12E"},
+	art: `{0}  ................................
+{0}..{1}################################{0}..
+{0}.{1}##################################{0}.
+{0}.{1}##################################{0}.
+{0}.{1}##################################{0}.
+{0}.{1}######      ##########  ##########{0}.
+{0}.{1}######  ########  ####  ##########{0}.
+{0}.{1}######  ################  ########{0}.
+{0}.{1}######  ########  ########  ######{0}.
+{0}.{1}######      ##############  ######{0}.
+{0}.{1}##################################{0}.
+{0}.{1}##################################{0}.
+{0}.{1}##################################{0}.
+{0}.{1}##################################{0}.
+{0}..{1}################################{0}..
+{0}  ................................`,
+	small: `{0}.------.
+{0}| {1}BAT {0} |
+{0}'------'`,
+}
+
+var sqlLogo = logoData{
+	colors: []string{"#B56E00", "#E38C00"},
+	art: `{0}       ....................
+{0} ......{1}oooooooooooooooooooo{0}......
+{0}.{1}oooooooooooooooooooooooooooooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooooo{0}.
+{0}.{1}ooooo      oo      oo  ooooooooo{0}.
+{0}.{1}ooooo  oooooo  oo  oo  ooooooooo{0}.
+{0}.{1}ooooo      oo  oo  oo  ooooooooo{0}.
+{0}.{1}ooooooooo  oo      oo  ooooooooo{0}.
+{0}.{1}ooooo      oooooo  oo      ooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooooo{0}.
+{0} ......{1}oooooooooooooooooooo{0}......
+{0}       ....................`,
+	small: `{0} .----.
+{0}| {1}SQL {0} |
+{0} '----'`,
+}
+
+var makefileLogo = logoData{
+	colors: []string{"#2F5A12", "#427819"},
+	art: `{0}  ................................
+{0}..{1}================================{0}..
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}.{1}==========  ==  ==  ==  =========={0}.
+{0}.{1}==========      ==  ==  =========={0}.
+{0}.{1}==========      ==    ============{0}.
+{0}.{1}==========  ==  ==  ==  =========={0}.
+{0}.{1}==========  ==  ==  ==  =========={0}.
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}..{1}================================{0}..
+{0}  ................................`,
+	small: `{0}.------.
+{0}| {1}MAKE{0} |
+{0}'------'`,
+}
+
+var dockerfileLogo = logoData{
+	colors: []string{"#1D63ED", "#2496ED"},
+	art: `{0}  ................................
+{0}..{1}################################{0}..
+{0}.{1}##################################{0}.
+{0}.{1}##################################{0}.
+{0}.{1}##################################{0}.
+{0}.{1}######    ####  ##  ##    ########{0}.
+{0}.{1}######  ##  ##  ##  ##  ##  ######{0}.
+{0}.{1}######  ##  ##    ####    ########{0}.
+{0}.{1}######  ##  ##  ##  ##  ##  ######{0}.
+{0}.{1}######    ####  ##  ##  ##  ######{0}.
+{0}.{1}##################################{0}.
+{0}.{1}##################################{0}.
+{0}.{1}##################################{0}.
+{0}.{1}##################################{0}.
+{0}..{1}################################{0}..
+{0}  ................................`,
+	small: `{0}.------.
+{0}| {1}DKR {0} |
+{0}'------'`,
+}
+
+var cmakeLogo = logoData{
+	colors: []string{"#064F8C", "#DA3434"},
+	art: `{0}                 ..
+{0}                .{1}=={0}.
+{0}               .{1}===={0}.
+{0}              .{1}======{0}.
+{0}             .{1}========{0}.
+{0}            .{1}=========={0}.
+{0}           .{1}============{0}.
+{0}         ..{1}=============={0}..
+{0}        .{1}==      ==  ==  =={0}.
+{0}       .{1}===  ======      ==={0}.
+{0}      .{1}====  ======      ===={0}.
+{0}     .{1}=====  ======  ==  ====={0}.
+{0}    .{1}======      ==  ==  ======{0}.
+{0}   .{1}============================{0}.
+{0} ..{1}=============================={0}..
+{0}....................................`,
+	small: `{0}   /\
+{0} /{1} CM {0}\
+{0}/______\`,
+}
+
+var starlarkLogo = logoData{
+	colors: []string{"#4CAF50", "#76D275"},
+	art: `{0}                 ..
+{0}                .{1}oo{0}.
+{0}               .{1}oooo{0}.
+{0}              .{1}oooooo{0}.
+{0}             .{1}oooooooo{0}.
+{0}.............{1}oooooooooo{0}.............
+{0}  ...{1}oooooooooooooooooooooooooo{0}...
+{0}     ...{1}oooooooooooooooooooo{0}...
+{0}        ..{1}oooooooooooooooo{0}..
+{0}          .{1}oooooooooooooo{0}.
+{0}         .{1}oooooooooooooooo{0}.
+{0}        .{1}ooooooo{0}....{1}ooooooo{0}.
+{0}       .{1}ooooo{0}...    ...{1}ooooo{0}.
+{0}       .{1}o{0}....          ....{1}o{0}.
+{0}       ..                  ..`,
+	small: `{0}  _/\_
+{0}> {1}STAR{0} <
+{0}  '\/'`,
+}
+
+var glslLogo = logoData{
+	colors: []string{"#3D6A8A", "#5686A5"},
+	art: `{0}       ....................
+{0}      .{1}oooooooooooooooooooo{0}.
+{0}     .{1}oooooooooooooooooooooo{0}.
+{0}    .{1}oooooooooooooooooooooooo{0}.
+{0}   .{1}oooooooooooooooooooooooooo{0}.
+{0}  .{1}ooooooo      oo  ooooooooooo{0}.
+{0} .{1}oooooooo  oooooo  oooooooooooo{0}.
+{0}.{1}ooooooooo  oo  oo  ooooooooooooo{0}.
+{0}.{1}ooooooooo  oo  oo  ooooooooooooo{0}.
+{0} .{1}oooooooo      oo      oooooooo{0}.
+{0}  .{1}oooooooooooooooooooooooooooo{0}.
+{0}   .{1}oooooooooooooooooooooooooo{0}.
+{0}    .{1}oooooooooooooooooooooooo{0}.
+{0}     .{1}oooooooooooooooooooooo{0}.
+{0}      .{1}oooooooooooooooooooo{0}.
+{0}       ....................`,
+	small: `{0}  ____
+{0}/ {1}GLSL{0} \
+{0}\______/`,
+}
+
+var assemblyLogo = logoData{
+	colors: []string{"#4E360D", "#6E4C13"},
+	art: `{0}  ................................
+{0}..{1}################################{0}..
+{0}.{1}##################################{0}.
+{0}.{1}##################################{0}.
+{0}.{1}##################################{0}.
+{0}.{1}######      ##      ##  ##  ######{0}.
+{0}.{1}######  ##  ##  ######      ######{0}.
+{0}.{1}######      ##      ##      ######{0}.
+{0}.{1}######  ##  ######  ##  ##  ######{0}.
+{0}.{1}######  ##  ##      ##  ##  ######{0}.
+{0}.{1}##################################{0}.
+{0}.{1}##################################{0}.
+{0}.{1}##################################{0}.
+{0}.{1}##################################{0}.
+{0}..{1}################################{0}..
+{0}  ................................`,
+	small: `{0}.------.
+{0}| {1}ASM {0} |
+{0}'------'`,
+}
+
+var fortranLogo = logoData{
+	colors: []string{"#3A3196", "#4D41B1"},
+	art: `{0}  ................................
+{0}..{1}================================{0}..
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}.{1}==============      =============={0}.
+{0}.{1}==============  =================={0}.
+{0}.{1}==============    ================{0}.
+{0}.{1}==============  =================={0}.
+{0}.{1}==============  =================={0}.
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}.{1}=================================={0}.
+{0}..{1}================================{0}..
+{0}  ................................`,
+	small: `{0}.------.
+{0}| {1}F90 {0} |
+{0}'------'`,
+}
+
+var solidityLogo = logoData{
+	colors: []string{"#7A4A31", "#AA6746"},
+	art: `{0}                ..
+{0}             ...{1}##{0}...
+{0}           ..{1}########{0}..
+{0}         ..{1}############{0}..
+{0}       ..{1}################{0}..
+{0}    ..      {1}##      ##  ###{0}...
+{0}  ..{1}##  ######  ##  ##  ######{0}..
+{0}..{1}####      ##  ##  ##  ########{0}..
+{0}..{1}########  ##  ##  ##  ########{0}..
+{0}  ..{1}##      ##      ##      ##{0}..
+{0}    ...{1}####################{0}...
+{0}       ..{1}################{0}..
+{0}         ..{1}############{0}..
+{0}           ..{1}########{0}..
+{0}             ...{1}##{0}...
+{0}                ..`,
+	small: `{0}  .''.
+{0}< {1}SOL {0} >
+{0}  '..'`,
+}
+
+var visualbasicLogo = logoData{
+	colors: []string{"#6F3F8F", "#945DB7"},
+	art: `{0}  ................................
+{0}..{1}oooooooooooooooooooooooooooooooo{0}..
+{0}.{1}oooooooooooooooooooooooooooooooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooooooo{0}.
+{0}.{1}oooooooooo  oo  oo    oooooooooooo{0}.
+{0}.{1}oooooooooo  oo  oo  oo  oooooooooo{0}.
+{0}.{1}oooooooooo  oo  oo    oooooooooooo{0}.
+{0}.{1}oooooooooo  oo  oo  oo  oooooooooo{0}.
+{0}.{1}oooooooooooo  oooo    oooooooooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooooooo{0}.
+{0}.{1}oooooooooooooooooooooooooooooooooo{0}.
+{0}..{1}oooooooooooooooooooooooooooooooo{0}..
+{0}  ................................`,
+	small: `{0}.------.
+{0}| {1} VB {0} |
+{0}'------'`,
 }
 
 func getLanguageLogo(language string) logoData {
@@ -405,6 +1462,30 @@ func getLanguageLogo(language string) logoData {
 	return defaultLogo
 }
 
+// RenderSmallLogo renders the language's three-line emblem for the compact
+// layout, falling back to a badge in its logo color.
+func RenderSmallLogo(language string) string {
+	logo := getLanguageLogo(language)
+	if logo.small != "" {
+		return renderColoredArt(logo.small, logo.colors)
+	}
+	color := lipgloss.Color("#F0883E")
+	if len(logo.colors) > 0 {
+		color = lipgloss.Color(logo.colors[0])
+	}
+	name := language
+	if name == "" {
+		name = "git"
+	}
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(color).
+		Foreground(color).
+		Bold(true).
+		Padding(0, 1).
+		Render(name)
+}
+
 func renderColoredArt(art string, colors []string) string {
 	if len(colors) == 0 {
 		return art
@@ -0,0 +1,55 @@
+package ui
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/fayssal-elmofatiche/gfetch/internal/git"
+	"github.com/fayssal-elmofatiche/gfetch/internal/i18n"
+)
+
+// Prompt fields served from the cache of the last full gfetch run.
+const (
+	PromptLanguage = "lang"
+	PromptLOC      = "loc"
+)
+
+// RenderPrompt formats a shell prompt segment with fields in the given
+// order, leaving out empty ones: "main ↑2↓1 2 modified ≡1 Go 4.9K". It is
+// plain text, since each shell needs its own escapes around colors, and
+// uses ASCII markers ("main ^2v1 ... $1") after SetASCII(true).
+func RenderPrompt(fields []string, p git.Prompt, language string, loc int) string {
+	var parts []string
+	for _, field := range fields {
+		var part string
+		switch strings.TrimSpace(field) {
+		case git.PromptBranch:
+			part = p.Branch
+		case git.PromptUpstream:
+			if p.Ahead > 0 {
+				part += glyphs.Up + strconv.Itoa(p.Ahead)
+			}
+			if p.Behind > 0 {
+				part += glyphs.Down + strconv.Itoa(p.Behind)
+			}
+		case git.PromptStatus:
+			if p.Status != i18n.T("clean") {
+				part = p.Status
+			}
+		case git.PromptStash:
+			if p.Stash > 0 {
+				part = glyphs.Stash + strconv.Itoa(p.Stash)
+			}
+		case PromptLanguage:
+			part = language
+		case PromptLOC:
+			if loc > 0 {
+				part = formatLOC(loc)
+			}
+		}
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,26 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/fayssal-elmofatiche/gfetch/internal/git"
+)
+
+func TestRenderPrompt(t *testing.T) {
+	defer SetASCII(false)
+	fields := []string{git.PromptBranch, git.PromptUpstream, git.PromptStatus, git.PromptStash, PromptLanguage, PromptLOC}
+	p := git.Prompt{Branch: "main", Ahead: 2, Behind: 1, Status: "3 modified", Stash: 1}
+	tests := []struct {
+		ascii bool
+		want  string
+	}{
+		{false, "main ↑2↓1 3 modified ≡1 Go 4.9K"},
+		{true, "main ^2v1 3 modified $1 Go 4.9K"},
+	}
+	for _, tt := range tests {
+		SetASCII(tt.ascii)
+		if got := RenderPrompt(fields, p, "Go", 4900); got != tt.want {
+			t.Errorf("ascii=%v: RenderPrompt() = %q, want %q", tt.ascii, got, tt.want)
+		}
+	}
+}